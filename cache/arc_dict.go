@@ -0,0 +1,503 @@
+package cache
+
+import (
+	"bytes"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// ARCDict is a Cache implementation based on the Adaptive Replacement Cache algorithm. It keeps the
+// entries that have been used once and the entries that have been used more than once in two
+// lists, and remembers the keys of the recently evicted entries in two ghost lists to adapt the
+// target size of the lists, so it resists the scans that would flush a LRU cache. The compute
+// operations like PutIfAbsent and CompareAndSwap update the value of a present entry without
+// moving it between the lists.
+//
+// ARCDict is not thread-safe, use LockCache for concurrent usage. The dictionary must be created
+// by NewARCDict.
+type ARCDict[K comparable, V any] struct {
+	cacheBase[K, V]
+	// entries contains the entries of all the lists, including the ghost entries.
+	entries map[K]*entry[K, V]
+	// recent contains the entries that have been used once.
+	recent entryList[K, V]
+	// frequent contains the entries that have been used more than once.
+	frequent entryList[K, V]
+	// recentGhost contains the keys of the entries evicted from the recent list.
+	recentGhost entryList[K, V]
+	// frequentGhost contains the keys of the entries evicted from the frequent list.
+	frequentGhost entryList[K, V]
+	// target is the target size of the recent list.
+	target int
+}
+
+// NewARCDict creates a new ARCDict with the specified maximum number of entries. It panics if the
+// capacity is not positive.
+func NewARCDict[K comparable, V any](capacity int) *ARCDict[K, V] {
+	d := new(ARCDict[K, V])
+	d.setCapacity(capacity)
+	d.entries = make(map[K]*entry[K, V])
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary and forgets the evicted keys, the eviction
+// callback is not called.
+func (d *ARCDict[K, V]) Clear() {
+	d.entries = make(map[K]*entry[K, V])
+	d.recent.clear()
+	d.frequent.clear()
+	d.recentGhost.clear()
+	d.frequentGhost.clear()
+	d.target = 0
+}
+
+// Clone returns a copy of this dictionary with the same capacity, state of the policy and eviction
+// callback. The hit and miss counters of the copy start from zero.
+func (d *ARCDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewARCDict[K, V](d.capacity)
+	newDict.onEvict = d.onEvict
+	newDict.target = d.target
+
+	lists := []*entryList[K, V]{&d.recent, &d.frequent, &d.recentGhost, &d.frequentGhost}
+	newLists := []*entryList[K, V]{
+		&newDict.recent, &newDict.frequent, &newDict.recentGhost, &newDict.frequentGhost,
+	}
+	for i, l := range lists {
+		for e := l.head; e != nil; e = e.next {
+			ne := &entry[K, V]{key: e.key, value: e.value}
+			newDict.entries[e.key] = ne
+			newLists[i].pushBack(ne)
+		}
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *ARCDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *ARCDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *ARCDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *ARCDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *ARCDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key, it
+// does not affect the policy.
+func (d *ARCDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.resident(k)
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *ARCDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*ARCDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for _, l := range []*entryList[K, V]{&d.recent, &d.frequent} {
+		for e := l.head; e != nil; e = e.next {
+			v, ok := od.resident(e.key)
+			if !ok || !internal.Equal(e.value, v.value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+// have been processed or the handler returns an error. The pairs that have been used once are
+// processed first.
+func (d *ARCDict[K, V]) ForEach(handler func(K, V) error) error {
+	for _, e := range d.snapshot() {
+		if err := handler(e.key, e.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key, and moves the pair to the list of
+// the frequently used pairs.
+func (d *ARCDict[K, V]) Get(k K) (V, bool) {
+	e, ok := d.resident(k)
+	d.record(ok)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	d.promote(e)
+
+	return e.value, true
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *ARCDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.Get(k)
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *ARCDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary.
+func (d *ARCDict[K, V]) Keys() []K {
+	entries := d.snapshot()
+	keys := make([]K, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, e.key)
+	}
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *ARCDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Peek returns the value which associated to the specified key without affecting the policy and
+// the counters.
+func (d *ARCDict[K, V]) Peek(k K) (V, bool) {
+	e, ok := d.resident(k)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Put associate the specified value with the specified key in this dictionary. A new pair is put
+// into the list of the recently used pairs unless its key has been evicted recently, and a pair is
+// evicted by the policy if the dictionary is full.
+func (d *ARCDict[K, V]) Put(k K, v V) V {
+	e, ok := d.entries[k]
+	if ok {
+		switch e.list {
+		case &d.recent, &d.frequent:
+			old := e.value
+			e.value = v
+			d.promote(e)
+			return old
+		case &d.recentGhost:
+			d.target = arcMin(d.capacity, d.target+arcMax(d.frequentGhost.size/d.recentGhost.size, 1))
+			if d.Size() >= d.capacity {
+				d.replace(false)
+			}
+		default:
+			d.target = arcMax(0, d.target-arcMax(d.recentGhost.size/d.frequentGhost.size, 1))
+			if d.Size() >= d.capacity {
+				d.replace(true)
+			}
+		}
+
+		e.list.remove(e)
+		e.value = v
+		d.frequent.pushBack(e)
+
+		var zero V
+		return zero
+	}
+
+	total := d.recent.size + d.frequent.size + d.recentGhost.size + d.frequentGhost.size
+	if d.recent.size+d.recentGhost.size >= d.capacity {
+		if d.recent.size < d.capacity {
+			d.forget(&d.recentGhost)
+			if d.Size() >= d.capacity {
+				d.replace(false)
+			}
+		} else {
+			d.evict(&d.recent)
+		}
+	} else if total >= d.capacity {
+		if total >= 2*d.capacity {
+			d.forget(&d.frequentGhost)
+		}
+		if d.Size() >= d.capacity {
+			d.replace(false)
+		}
+	}
+
+	e = &entry[K, V]{key: k, value: v}
+	d.entries[k] = e
+	d.recent.pushBack(e)
+
+	var zero V
+	return zero
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *ARCDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key, the eviction callback is not called.
+func (d *ARCDict[K, V]) Remove(k K) V {
+	e, ok := d.resident(k)
+	if !ok {
+		var zero V
+		return zero
+	}
+
+	delete(d.entries, k)
+	e.list.remove(e)
+
+	return e.value
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary, and
+// moves the pair to the list of the frequently used pairs.
+func (d *ARCDict[K, V]) Replace(k K, v V) (V, bool) {
+	e, ok := d.resident(k)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	old := e.value
+	e.value = v
+	d.promote(e)
+
+	return old, true
+}
+
+// Resize changes the maximum number of entries of this dictionary, and evicts the pairs by the
+// policy if the dictionary has more pairs than the new capacity. It panics if the capacity is not
+// positive.
+func (d *ARCDict[K, V]) Resize(capacity int) {
+	d.setCapacity(capacity)
+	d.target = arcMin(d.target, capacity)
+
+	for d.Size() > d.capacity {
+		d.replace(false)
+	}
+	for d.recent.size+d.recentGhost.size > d.capacity && d.recentGhost.size > 0 {
+		d.forget(&d.recentGhost)
+	}
+	for d.Size()+d.recentGhost.size+d.frequentGhost.size > 2*d.capacity {
+		d.forget(&d.frequentGhost)
+	}
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *ARCDict[K, V]) Size() int {
+	return d.recent.size + d.frequent.size
+}
+
+// String returns the string representation of this dictionary.
+func (d *ARCDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	for i, e := range d.snapshot() {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e.key))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(e.value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains all the values in this dictionary.
+func (d *ARCDict[K, V]) Values() []V {
+	entries := d.snapshot()
+	values := make([]V, 0, len(entries))
+	for _, e := range entries {
+		values = append(values, e.value)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the ARCDict as a JSON object, the evicted keys are not included.
+func (d *ARCDict[K, V]) MarshalJSON() ([]byte, error) {
+	return internal.MarshalJSONObject(d.Keys(), d.Values())
+}
+
+// UnmarshalJSON unmarshals a JSON object into the ARCDict, the pairs are put in the order they
+// appear in the JSON object. A zero value ARCDict takes the number of members as its capacity.
+func (d *ARCDict[K, V]) UnmarshalJSON(b []byte) error {
+	keys := make([]K, 0)
+	values := make([]V, 0)
+	if err := internal.UnmarshalJSONObject(b, func(k K, v V) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}); err != nil {
+		return err
+	}
+
+	if d.entries == nil {
+		if len(keys) == 0 {
+			return collection.ErrInvalidCapacity
+		}
+		*d = *NewARCDict[K, V](len(keys))
+	}
+
+	d.Clear()
+	onEvict := d.onEvict
+	d.onEvict = nil
+	for i, k := range keys {
+		d.Put(k, values[i])
+	}
+	d.onEvict = onEvict
+
+	return nil
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations. It does not change the order of the entries,
+// the value of a present key is updated in place, and a new key is put by Put.
+func (d *ARCDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	var old V
+	e, ok := d.resident(k)
+	if ok {
+		old = e.value
+	}
+
+	v, keep := remapping(old, ok)
+	if !keep {
+		if ok {
+			d.Remove(k)
+		}
+		var zero V
+		return zero, false
+	}
+
+	if ok {
+		e.value = v
+	} else {
+		d.Put(k, v)
+	}
+
+	return v, true
+}
+
+// evict evicts the least recently used entry of the list, and removes it from the dictionary.
+func (d *ARCDict[K, V]) evict(l *entryList[K, V]) {
+	e := l.popFront()
+	delete(d.entries, e.key)
+	d.evicted(e)
+}
+
+// forget removes the least recently used key of the ghost list.
+func (d *ARCDict[K, V]) forget(l *entryList[K, V]) {
+	e := l.popFront()
+	if e != nil {
+		delete(d.entries, e.key)
+	}
+}
+
+// promote moves the resident entry to the back of the frequent list.
+func (d *ARCDict[K, V]) promote(e *entry[K, V]) {
+	e.list.remove(e)
+	d.frequent.pushBack(e)
+}
+
+// replace evicts the least recently used entry of the recent list or the frequent list by the
+// target size, and remembers its key in the corresponding ghost list. The inFrequentGhost
+// indicates whether the requested key is in the frequent ghost list.
+func (d *ARCDict[K, V]) replace(inFrequentGhost bool) {
+	var from, to *entryList[K, V]
+	if d.recent.size > 0 &&
+		(d.recent.size > d.target || (inFrequentGhost && d.recent.size == d.target) || d.frequent.size == 0) {
+		from, to = &d.recent, &d.recentGhost
+	} else if d.frequent.size > 0 {
+		from, to = &d.frequent, &d.frequentGhost
+	} else {
+		return
+	}
+
+	e := from.popFront()
+	d.evicted(e)
+
+	var zero V
+	e.value = zero
+	to.pushBack(e)
+}
+
+// resident returns the entry of the specified key if it is in the recent list or the frequent list.
+func (d *ARCDict[K, V]) resident(k K) (*entry[K, V], bool) {
+	e, ok := d.entries[k]
+	if !ok || (e.list != &d.recent && e.list != &d.frequent) {
+		return nil, false
+	}
+
+	return e, true
+}
+
+// snapshot returns all resident entries, the entries in the recent list are returned first.
+func (d *ARCDict[K, V]) snapshot() []*entry[K, V] {
+	entries := make([]*entry[K, V], 0, d.Size())
+	for e := d.recent.head; e != nil; e = e.next {
+		entries = append(entries, e)
+	}
+	for e := d.frequent.head; e != nil; e = e.next {
+		entries = append(entries, e)
+	}
+
+	return entries
+}
+
+// arcMax returns the larger one of the two integers.
+func arcMax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// arcMin returns the smaller one of the two integers.
+func arcMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
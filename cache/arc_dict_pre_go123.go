@@ -0,0 +1,31 @@
+//go:build !go1.23
+
+package cache
+
+// KeysIter returns a channel iterator of all keys in this dictionary.
+func (d *ARCDict[K, V]) KeysIter() <-chan K {
+	entries := d.snapshot()
+
+	ch := make(chan K)
+	go func() {
+		for _, e := range entries {
+			ch <- e.key
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary.
+func (d *ARCDict[K, V]) ValuesIter() <-chan V {
+	entries := d.snapshot()
+
+	ch := make(chan V)
+	go func() {
+		for _, e := range entries {
+			ch <- e.value
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestARCDictCache(t *testing.T) {
+	a := assert.New(t)
+
+	testCache(a, func(capacity int) Cache[int, int] {
+		return NewARCDict[int, int](capacity)
+	})
+}
+
+func TestARCDictScanResistance(t *testing.T) {
+	a := assert.New(t)
+	arc := NewARCDict[int, int](4)
+	lru := NewLRUDict[int, int](4)
+
+	for _, c := range []Cache[int, int]{arc, lru} {
+		c.Put(1, 1)
+		c.Put(2, 2)
+		c.Get(1)
+		c.Get(2)
+		for i := 100; i < 200; i++ {
+			c.Put(i, i)
+		}
+	}
+
+	a.TrueNow(arc.ContainsKey(1))
+	a.TrueNow(arc.ContainsKey(2))
+	a.NotTrueNow(lru.ContainsKey(1))
+	a.NotTrueNow(lru.ContainsKey(2))
+	a.EqualNow(4, arc.Size())
+}
+
+func TestARCDictGhost(t *testing.T) {
+	a := assert.New(t)
+	d := NewARCDict[int, int](2)
+
+	evicted := []int{}
+	d.OnEvict(func(k, v int) {
+		evicted = append(evicted, k)
+	})
+
+	d.Put(1, 1)
+	d.Put(2, 2)
+	d.Put(3, 3)
+	a.EqualNow([]int{1}, evicted)
+	a.NotTrueNow(d.ContainsKey(1))
+	_, ok := d.Get(1)
+	a.NotTrueNow(ok)
+	_, ok = d.Peek(1)
+	a.NotTrueNow(ok)
+	a.EqualNow(0, d.Remove(1))
+
+	// the key evicted recently is put into the frequent list
+	d.Put(1, 10)
+	a.EqualNow([]int{1, 2}, evicted)
+	a.EqualNow([]int{3, 1}, d.Keys())
+	a.EqualNow(10, d.GetDefault(1, 0))
+
+	d.Put(4, 4)
+	a.EqualNow([]int{1, 2, 3}, evicted)
+	keys := d.Keys()
+	sort.Ints(keys)
+	a.EqualNow([]int{1, 4}, keys)
+
+	cloned := d.Clone().(*ARCDict[int, int])
+	a.TrueNow(d.Equals(cloned))
+	cloned.Put(3, 30)
+	a.EqualNow(30, cloned.GetDefault(3, 0))
+	a.NotTrueNow(d.ContainsKey(3))
+
+	d.Clear()
+	d.Put(2, 2)
+	a.EqualNow([]int{2}, d.Keys())
+}
+
+func TestARCDictJSON(t *testing.T) {
+	a := assert.New(t)
+	d := NewARCDict[string, int](2)
+	d.Put("a", 1)
+	d.Put("b", 2)
+	d.Get("a")
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"b":2,"a":1}`, string(b))
+
+	var zero ARCDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), &zero))
+	a.EqualNow(3, zero.Capacity())
+	a.EqualNow([]string{"x", "y", "z"}, zero.Keys())
+}
@@ -0,0 +1,97 @@
+package cache
+
+import "github.com/ghosind/collection"
+
+// Cache is a dictionary with a maximum number of entries, it evicts the entries by its eviction
+// policy when a new entry is put into the full cache. The implementations of the different policies
+// can be swapped by the constructors only.
+type Cache[K comparable, V any] interface {
+	collection.Dict[K, V]
+
+	// Capacity returns the maximum number of entries of this cache.
+	Capacity() int
+
+	// Hits returns the number of the Get and GetDefault calls that found the key.
+	Hits() uint64
+
+	// Misses returns the number of the Get and GetDefault calls that did not find the key.
+	Misses() uint64
+
+	// OnEvict sets the callback that is called with the evicted pair when a pair is evicted because
+	// the cache is full or resized.
+	OnEvict(handler func(K, V))
+
+	// Peek returns the value which associated to the specified key without affecting the eviction
+	// policy and the counters.
+	Peek(k K) (V, bool)
+
+	// ResetStats resets the hit and miss counters to zero.
+	ResetStats()
+
+	// Resize changes the maximum number of entries of this cache, and evicts the pairs by the
+	// eviction policy if the cache has more pairs than the new capacity. It panics if the capacity
+	// is not positive.
+	Resize(capacity int)
+}
+
+// cacheBase is the common part of the caches, it holds the capacity, the eviction callback and the
+// counters.
+type cacheBase[K comparable, V any] struct {
+	capacity int
+	onEvict  func(K, V)
+	hits     uint64
+	misses   uint64
+}
+
+// Capacity returns the maximum number of entries of this cache.
+func (c *cacheBase[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Hits returns the number of the Get and GetDefault calls that found the key.
+func (c *cacheBase[K, V]) Hits() uint64 {
+	return c.hits
+}
+
+// Misses returns the number of the Get and GetDefault calls that did not find the key.
+func (c *cacheBase[K, V]) Misses() uint64 {
+	return c.misses
+}
+
+// OnEvict sets the callback that is called with the evicted pair when a pair is evicted because
+// the cache is full or resized.
+func (c *cacheBase[K, V]) OnEvict(handler func(K, V)) {
+	c.onEvict = handler
+}
+
+// ResetStats resets the hit and miss counters to zero.
+func (c *cacheBase[K, V]) ResetStats() {
+	c.hits = 0
+	c.misses = 0
+}
+
+// evicted calls the eviction callback with the evicted entry if the callback is set.
+func (c *cacheBase[K, V]) evicted(e *entry[K, V]) {
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+// record increases the hit or miss counter.
+func (c *cacheBase[K, V]) record(hit bool) {
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// setCapacity sets the maximum number of entries of the cache, it panics if the capacity is not
+// positive.
+func (c *cacheBase[K, V]) setCapacity(capacity int) {
+	if capacity <= 0 {
+		panic(collection.ErrInvalidCapacity)
+	}
+
+	c.capacity = capacity
+}
@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package cache
+
+import (
+	"github.com/ghosind/go-assert"
+)
+
+func testCacheIter(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(5)
+	for i := 0; i < 5; i++ {
+		c.Put(i, i*10)
+	}
+
+	keys := []int{}
+	for k, v := range c.Iter() {
+		a.EqualNow(k*10, v)
+		keys = append(keys, k)
+	}
+	a.EqualNow(c.Keys(), keys)
+
+	keys = []int{}
+	for k := range c.KeysIter() {
+		keys = append(keys, k)
+	}
+	a.EqualNow(c.Keys(), keys)
+
+	values := []int{}
+	for v := range c.ValuesIter() {
+		values = append(values, v)
+	}
+	a.EqualNow(c.Values(), values)
+
+	for range c.Iter() {
+		// yield should returns false
+		break
+	}
+	for range c.KeysIter() {
+		break
+	}
+	for range c.ValuesIter() {
+		break
+	}
+}
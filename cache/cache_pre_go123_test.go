@@ -0,0 +1,26 @@
+//go:build !go1.23
+
+package cache
+
+import (
+	"github.com/ghosind/go-assert"
+)
+
+func testCacheIter(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(5)
+	for i := 0; i < 5; i++ {
+		c.Put(i, i*10)
+	}
+
+	keys := []int{}
+	for k := range c.KeysIter() {
+		keys = append(keys, k)
+	}
+	a.EqualNow(c.Keys(), keys)
+
+	values := []int{}
+	for v := range c.ValuesIter() {
+		values = append(values, v)
+	}
+	a.EqualNow(c.Values(), values)
+}
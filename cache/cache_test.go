@@ -0,0 +1,431 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type cacheConstructor func(capacity int) Cache[int, int]
+
+func testCache(a *assert.Assertion, constructor cacheConstructor) {
+	testCachePut(a, constructor)
+	testCacheGet(a, constructor)
+	testCacheRemove(a, constructor)
+	testCacheCompute(a, constructor)
+	testCacheComputeOrder(a, constructor)
+	testCacheResize(a, constructor)
+	testCacheClone(a, constructor)
+	testCacheForEach(a, constructor)
+	testCacheString(a, constructor)
+	testCacheJSON(a, constructor)
+	testCacheIter(a, constructor)
+}
+
+func testCachePut(a *assert.Assertion, constructor cacheConstructor) {
+	a.PanicOfNow(func() {
+		constructor(0)
+	}, collection.ErrInvalidCapacity)
+
+	c := constructor(10)
+	a.EqualNow(10, c.Capacity())
+	a.TrueNow(c.IsEmpty())
+
+	evicted := make(map[int]int)
+	c.OnEvict(func(k, v int) {
+		a.EqualNow(k*10, v)
+		evicted[k]++
+	})
+
+	for i := 0; i < 15; i++ {
+		a.EqualNow(0, c.Put(i, i*10))
+		a.TrueNow(c.Size() <= 10)
+	}
+	a.EqualNow(10, c.Size())
+	a.EqualNow(5, len(evicted))
+	for k, n := range evicted {
+		a.EqualNow(1, n)
+		a.NotTrueNow(c.ContainsKey(k))
+	}
+
+	k := c.Keys()[0]
+	a.EqualNow(k*10, c.Put(k, k*10))
+	a.EqualNow(10, c.Size())
+	a.EqualNow(5, len(evicted))
+}
+
+func testCacheGet(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(5)
+	c.Put(1, 10)
+	c.Put(2, 20)
+
+	v, ok := c.Get(1)
+	a.TrueNow(ok)
+	a.EqualNow(10, v)
+	_, ok = c.Get(3)
+	a.NotTrueNow(ok)
+	a.EqualNow(20, c.GetDefault(2, 0))
+	a.EqualNow(-1, c.GetDefault(4, -1))
+	a.EqualNow(uint64(2), c.Hits())
+	a.EqualNow(uint64(2), c.Misses())
+
+	v, ok = c.Peek(2)
+	a.TrueNow(ok)
+	a.EqualNow(20, v)
+	_, ok = c.Peek(3)
+	a.NotTrueNow(ok)
+	a.TrueNow(c.ContainsKey(1))
+	a.NotTrueNow(c.ContainsKey(3))
+	a.EqualNow(uint64(2), c.Hits())
+	a.EqualNow(uint64(2), c.Misses())
+
+	c.ResetStats()
+	a.EqualNow(uint64(0), c.Hits())
+	a.EqualNow(uint64(0), c.Misses())
+}
+
+func testCacheRemove(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(3)
+	evicted := 0
+	c.OnEvict(func(int, int) {
+		evicted++
+	})
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	c.Put(3, 3)
+
+	a.EqualNow(2, c.Remove(2))
+	a.EqualNow(0, c.Remove(2))
+	a.EqualNow(2, c.Size())
+
+	old, ok := c.Replace(1, 10)
+	a.TrueNow(ok)
+	a.EqualNow(1, old)
+	_, ok = c.Replace(2, 20)
+	a.NotTrueNow(ok)
+	a.NotTrueNow(c.ContainsKey(2))
+
+	c.Put(4, 4)
+	a.EqualNow(3, c.Size())
+	a.EqualNow(0, evicted)
+
+	c.Clear()
+	a.TrueNow(c.IsEmpty())
+	a.EqualNow(0, evicted)
+	for i := 0; i < 3; i++ {
+		c.Put(i, i)
+	}
+	a.EqualNow(3, c.Size())
+	a.EqualNow(0, evicted)
+}
+
+func testCacheCompute(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(3)
+	evicted := 0
+	c.OnEvict(func(int, int) {
+		evicted++
+	})
+
+	for i := 0; i < 3; i++ {
+		actual, loaded := c.PutIfAbsent(i, i)
+		a.NotTrueNow(loaded)
+		a.EqualNow(i, actual)
+	}
+	actual, loaded := c.PutIfAbsent(0, 10)
+	a.TrueNow(loaded)
+	a.EqualNow(0, actual)
+
+	a.EqualNow(11, c.Merge(1, 10, func(old, v int) int {
+		return old + v
+	}))
+	a.TrueNow(c.CompareAndSwap(2, 2, 20))
+	a.NotTrueNow(c.CompareAndSwap(2, 2, 30))
+	a.EqualNow(20, c.ComputeIfAbsent(2, func(int) int {
+		return 30
+	}))
+	v, ok := c.ComputeIfPresent(0, func(k, old int) (int, bool) {
+		return old + 1, true
+	})
+	a.TrueNow(ok)
+	a.EqualNow(1, v)
+	a.EqualNow(3, c.Size())
+	a.EqualNow(0, evicted)
+	a.EqualNow(uint64(0), c.Hits()+c.Misses())
+
+	v, ok = c.Compute(3, func(k, old int, loaded bool) (int, bool) {
+		a.NotTrueNow(loaded)
+		return k, true
+	})
+	a.TrueNow(ok)
+	a.EqualNow(3, v)
+	a.EqualNow(3, c.Size())
+	a.EqualNow(1, evicted)
+
+	a.NotTrueNow(c.CompareAndDelete(3, 0))
+	a.TrueNow(c.CompareAndDelete(3, 3))
+	a.NotTrueNow(c.ContainsKey(3))
+	a.EqualNow(2, c.Size())
+	a.EqualNow(1, evicted)
+}
+
+func testCacheComputeOrder(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(2)
+	evicted := []int{}
+	c.OnEvict(func(k, _ int) {
+		evicted = append(evicted, k)
+	})
+
+	c.Put(1, 1)
+	c.Put(2, 2)
+	for i := 0; i < 10; i++ {
+		_, loaded := c.PutIfAbsent(1, 10)
+		a.TrueNow(loaded)
+		a.NotTrueNow(c.CompareAndSwap(1, 10, 20))
+		a.EqualNow(1, c.ComputeIfAbsent(1, func(int) int {
+			return 10
+		}))
+	}
+	a.TrueNow(c.CompareAndSwap(1, 1, 10))
+	c.Put(3, 3)
+	a.EqualNow([]int{1}, evicted)
+
+	c.Put(4, 4)
+	a.EqualNow([]int{1, 2}, evicted)
+	v, ok := c.ComputeIfPresent(3, func(_, old int) (int, bool) {
+		return old * 10, true
+	})
+	a.TrueNow(ok)
+	a.EqualNow(30, v)
+	a.EqualNow(30, c.Merge(3, 1, func(old, _ int) int {
+		return old
+	}))
+	c.Put(5, 5)
+	a.EqualNow([]int{1, 2, 3}, evicted)
+}
+
+func testCacheResize(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(8)
+	for i := 0; i < 8; i++ {
+		c.Put(i, i)
+	}
+
+	evicted := 0
+	c.OnEvict(func(int, int) {
+		evicted++
+	})
+
+	c.Resize(3)
+	a.EqualNow(3, c.Capacity())
+	a.EqualNow(3, c.Size())
+	a.EqualNow(5, evicted)
+
+	c.Resize(5)
+	for i := 10; i < 20; i++ {
+		c.Put(i, i)
+	}
+	a.EqualNow(5, c.Size())
+
+	a.PanicOfNow(func() {
+		c.Resize(0)
+	}, collection.ErrInvalidCapacity)
+}
+
+func testCacheClone(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(3)
+	c.Put(1, 1)
+	c.Put(2, 2)
+
+	cloned := c.Clone().(Cache[int, int])
+	a.TrueNow(c.Equals(cloned))
+	a.EqualNow(3, cloned.Capacity())
+
+	cloned.Put(3, 3)
+	a.NotTrueNow(c.Equals(cloned))
+	a.EqualNow(2, c.Size())
+	a.NotTrueNow(c.Equals(constructor(3)))
+	a.NotTrueNow(c.Equals(nil))
+}
+
+func testCacheForEach(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(5)
+	for i := 0; i < 5; i++ {
+		c.Put(i, i*10)
+	}
+	c.Get(3)
+
+	keys := c.Keys()
+	values := c.Values()
+	a.EqualNow(5, len(keys))
+	for i, k := range keys {
+		a.EqualNow(k*10, values[i])
+	}
+
+	visited := []int{}
+	a.NilNow(c.ForEach(func(k, v int) error {
+		a.EqualNow(k*10, v)
+		visited = append(visited, k)
+		return nil
+	}))
+	a.EqualNow(keys, visited)
+	sort.Ints(visited)
+	a.EqualNow([]int{0, 1, 2, 3, 4}, visited)
+
+	errStop := errors.New("stop")
+	n := 0
+	a.EqualNow(errStop, c.ForEach(func(int, int) error {
+		n++
+		return errStop
+	}))
+	a.EqualNow(1, n)
+}
+
+func testCacheString(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(3)
+	a.EqualNow("dict[]", c.String())
+
+	c.Put(1, 10)
+	c.Put(2, 20)
+	str := c.String()
+	a.TrueNow(strings.HasPrefix(str, "dict["))
+	a.TrueNow(strings.Contains(str, "1: 10"))
+	a.TrueNow(strings.Contains(str, "2: 20"))
+}
+
+func testCacheJSON(a *assert.Assertion, constructor cacheConstructor) {
+	c := constructor(3)
+	c.Put(1, 10)
+	c.Put(2, 20)
+
+	b, err := json.Marshal(c)
+	a.NilNow(err)
+
+	c2 := constructor(3)
+	a.NilNow(json.Unmarshal(b, c2))
+	a.TrueNow(c.Equals(c2))
+
+	a.NilNow(json.Unmarshal([]byte(`{"1":1,"2":2,"3":3,"4":4}`), c2))
+	a.EqualNow(3, c2.Size())
+	a.NotNilNow(json.Unmarshal([]byte(`[1,2]`), c2))
+}
+
+func TestLockCache(t *testing.T) {
+	a := assert.New(t)
+
+	testCache(a, func(capacity int) Cache[int, int] {
+		return NewLockCache(Cache[int, int](NewLRUDict[int, int](capacity)))
+	})
+	testCache(a, func(capacity int) Cache[int, int] {
+		return NewLockCache(Cache[int, int](NewARCDict[int, int](capacity)))
+	})
+}
+
+func TestLockCacheConcurrent(t *testing.T) {
+	a := assert.New(t)
+	caches := []Cache[int, int]{
+		NewLRUDict[int, int](100),
+		NewLFUDict[int, int](100),
+		NewARCDict[int, int](100),
+	}
+
+	for _, data := range caches {
+		c := NewLockCache(data)
+		evicted := 0
+		c.OnEvict(func(int, int) {
+			evicted++
+		})
+
+		done := make(chan struct{})
+		for i := 0; i < 10; i++ {
+			go func(n int) {
+				defer func() { done <- struct{}{} }()
+				for j := 0; j < 100; j++ {
+					c.Put(n*100+j, j)
+					c.Get(n*100 + j/2)
+					c.Peek(j)
+				}
+			}(i)
+		}
+		for i := 0; i < 10; i++ {
+			<-done
+		}
+
+		a.EqualNow(100, c.Size())
+		a.EqualNow(900, evicted)
+		a.EqualNow(uint64(1000), c.Hits()+c.Misses())
+	}
+}
+
+func TestLockCacheEqualsConcurrently(t *testing.T) {
+	a := assert.New(t)
+	c1 := NewLockCache(Cache[int, int](NewLRUDict[int, int](100)))
+	c2 := NewLockCache(Cache[int, int](NewLRUDict[int, int](100)))
+	for i := 0; i < 100; i++ {
+		c1.Put(i, i)
+		c2.Put(i, i)
+	}
+
+	done := make(chan struct{})
+	for _, pair := range [][2]*LockCache[int, int]{{c1, c2}, {c2, c1}} {
+		go func(x, y *LockCache[int, int]) {
+			defer func() { done <- struct{}{} }()
+			for i := 0; i < 10000; i++ {
+				a.TrueNow(x.Equals(y))
+			}
+		}(pair[0], pair[1])
+	}
+	<-done
+	<-done
+}
+
+func benchmarkCache(b *testing.B, constructor cacheConstructor) {
+	c := constructor(1000)
+	r := rand.New(rand.NewSource(1))
+	keys := make([]int, 1<<16)
+	for i := range keys {
+		if i%4 == 0 {
+			// scan over the keys that are used only once
+			keys[i] = 10000 + i
+		} else {
+			// the hot keys follow a skewed distribution
+			keys[i] = int(r.ExpFloat64() * 500)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i&(len(keys)-1)]
+		if _, ok := c.Get(k); !ok {
+			c.Put(k, k)
+		}
+	}
+	b.StopTimer()
+
+	if total := c.Hits() + c.Misses(); total > 0 {
+		b.ReportMetric(float64(c.Hits())/float64(total), "hit-ratio")
+	}
+}
+
+func BenchmarkLRUDict(b *testing.B) {
+	benchmarkCache(b, func(capacity int) Cache[int, int] {
+		return NewLRUDict[int, int](capacity)
+	})
+}
+
+func BenchmarkLFUDict(b *testing.B) {
+	benchmarkCache(b, func(capacity int) Cache[int, int] {
+		return NewLFUDict[int, int](capacity)
+	})
+}
+
+func BenchmarkARCDict(b *testing.B) {
+	benchmarkCache(b, func(capacity int) Cache[int, int] {
+		return NewARCDict[int, int](capacity)
+	})
+}
@@ -0,0 +1,81 @@
+package cache
+
+// entry is a key-value pair in the cache, it is also a node of the doubly linked list that keeps
+// the order of the pairs.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// freq is the number of the accesses of the entry, it is used by the LFUDict only.
+	freq int
+	// list is the list that contains the entry, it is nil if the entry is not in any list.
+	list *entryList[K, V]
+	prev *entry[K, V]
+	next *entry[K, V]
+}
+
+// entryList is a doubly linked list of the cache entries, the front of the list is the oldest
+// entry and the back is the newest one.
+type entryList[K comparable, V any] struct {
+	head *entry[K, V]
+	tail *entry[K, V]
+	size int
+}
+
+// clear removes all entries from the list.
+func (l *entryList[K, V]) clear() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+}
+
+// moveToBack moves the entry to the back of the list.
+func (l *entryList[K, V]) moveToBack(e *entry[K, V]) {
+	if e == l.tail {
+		return
+	}
+
+	l.remove(e)
+	l.pushBack(e)
+}
+
+// pushBack adds the entry to the back of the list.
+func (l *entryList[K, V]) pushBack(e *entry[K, V]) {
+	e.list = l
+	e.prev = l.tail
+	e.next = nil
+	if l.tail == nil {
+		l.head = e
+	} else {
+		l.tail.next = e
+	}
+	l.tail = e
+	l.size++
+}
+
+// remove removes the entry from the list.
+func (l *entryList[K, V]) remove(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		l.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		l.tail = e.prev
+	}
+	e.list = nil
+	e.prev = nil
+	e.next = nil
+	l.size--
+}
+
+// popFront removes and returns the front entry of the list, it returns nil if the list is empty.
+func (l *entryList[K, V]) popFront() *entry[K, V] {
+	e := l.head
+	if e != nil {
+		l.remove(e)
+	}
+
+	return e
+}
@@ -0,0 +1,468 @@
+package cache
+
+import (
+	"bytes"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// LFUDict is a Cache implementation that evicts the least frequently used entry when a new entry
+// is put into the full dictionary, and the least recently used one is evicted if there are several
+// entries with the same frequency. Get, GetDefault, Put and Replace increase the frequency of the
+// entry, and the other reading methods do not change it. The compute operations like PutIfAbsent
+// and CompareAndSwap update the value of a present entry without changing its frequency.
+//
+// The entries with the same frequency are kept in a bucket, and the buckets are linked in ascending
+// order of the frequencies, so the least frequently used entry is found in constant time. LFUDict is not thread-safe, use LockCache for concurrent usage. The dictionary must be
+// created by NewLFUDict.
+type LFUDict[K comparable, V any] struct {
+	cacheBase[K, V]
+	entries map[K]*entry[K, V]
+	buckets map[int]*lfuBucket[K, V]
+	// head is the bucket of the minimum frequency, it is nil if the dictionary is empty.
+	head *lfuBucket[K, V]
+}
+
+// lfuBucket is the list of the entries with the same frequency from the least recently used one,
+// and it is a node of the doubly linked list of the buckets in ascending order of the frequencies.
+type lfuBucket[K comparable, V any] struct {
+	entryList[K, V]
+	freq int
+	prev *lfuBucket[K, V]
+	next *lfuBucket[K, V]
+}
+
+// NewLFUDict creates a new LFUDict with the specified maximum number of entries. It panics if the
+// capacity is not positive.
+func NewLFUDict[K comparable, V any](capacity int) *LFUDict[K, V] {
+	d := new(LFUDict[K, V])
+	d.setCapacity(capacity)
+	d.entries = make(map[K]*entry[K, V])
+	d.buckets = make(map[int]*lfuBucket[K, V])
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary, the eviction callback is not called.
+func (d *LFUDict[K, V]) Clear() {
+	d.entries = make(map[K]*entry[K, V])
+	d.buckets = make(map[int]*lfuBucket[K, V])
+	d.head = nil
+}
+
+// Clone returns a copy of this dictionary with the same capacity, frequencies and eviction
+// callback. The hit and miss counters of the copy start from zero.
+func (d *LFUDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewLFUDict[K, V](d.capacity)
+	newDict.onEvict = d.onEvict
+
+	var prev *lfuBucket[K, V]
+	for b := d.head; b != nil; b = b.next {
+		prev = newDict.bucket(b.freq, prev)
+		for e := b.entryList.head; e != nil; e = e.next {
+			newDict.add(e.key, e.value, prev)
+		}
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *LFUDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *LFUDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *LFUDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *LFUDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *LFUDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key, it
+// does not change the frequency of the entry.
+func (d *LFUDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.entries[k]
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *LFUDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*LFUDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for k, e := range d.entries {
+		oe, ok := od.entries[k]
+		if !ok || !internal.Equal(e.value, oe.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+// have been processed or the handler returns an error.
+func (d *LFUDict[K, V]) ForEach(handler func(K, V) error) error {
+	for _, e := range d.snapshot() {
+		if err := handler(e.key, e.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Frequency returns the number of the accesses of the pair with the specified key, it returns zero
+// if this dictionary contains no pair with the key.
+func (d *LFUDict[K, V]) Frequency(k K) int {
+	e, ok := d.entries[k]
+	if !ok {
+		return 0
+	}
+
+	return e.freq
+}
+
+// Get returns the value which associated to the specified key, and increases the frequency of the
+// pair.
+func (d *LFUDict[K, V]) Get(k K) (V, bool) {
+	e, ok := d.entries[k]
+	d.record(ok)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	d.touch(e)
+
+	return e.value, true
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *LFUDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.Get(k)
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *LFUDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary from the least frequently
+// used one.
+func (d *LFUDict[K, V]) Keys() []K {
+	entries := d.snapshot()
+	keys := make([]K, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, e.key)
+	}
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *LFUDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Peek returns the value which associated to the specified key without changing the frequency of
+// the entry and the counters.
+func (d *LFUDict[K, V]) Peek(k K) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Put associate the specified value with the specified key in this dictionary, and increases the
+// frequency of the pair. The least frequently used pair is evicted if the dictionary is full.
+func (d *LFUDict[K, V]) Put(k K, v V) V {
+	e, ok := d.entries[k]
+	if ok {
+		old := e.value
+		e.value = v
+		d.touch(e)
+		return old
+	}
+
+	if d.Size() >= d.capacity {
+		d.evicted(d.removeEldest())
+	}
+	d.add(k, v, d.bucket(1, nil))
+
+	var zero V
+	return zero
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *LFUDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key, the eviction callback is not called.
+func (d *LFUDict[K, V]) Remove(k K) V {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero
+	}
+
+	d.remove(e)
+
+	return e.value
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary, and
+// increases the frequency of the pair.
+func (d *LFUDict[K, V]) Replace(k K, v V) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	old := e.value
+	e.value = v
+	d.touch(e)
+
+	return old, true
+}
+
+// Resize changes the maximum number of entries of this dictionary, and evicts the least frequently
+// used pairs if the dictionary has more pairs than the new capacity. It panics if the capacity is
+// not positive.
+func (d *LFUDict[K, V]) Resize(capacity int) {
+	d.setCapacity(capacity)
+
+	for d.Size() > d.capacity {
+		d.evicted(d.removeEldest())
+	}
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *LFUDict[K, V]) Size() int {
+	return len(d.entries)
+}
+
+// String returns the string representation of this dictionary.
+func (d *LFUDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	for i, e := range d.snapshot() {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e.key))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(e.value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains all the values in this dictionary from the least frequently
+// used one.
+func (d *LFUDict[K, V]) Values() []V {
+	entries := d.snapshot()
+	values := make([]V, 0, len(entries))
+	for _, e := range entries {
+		values = append(values, e.value)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the LFUDict as a JSON object, the members are in the order from the least
+// frequently used pair.
+func (d *LFUDict[K, V]) MarshalJSON() ([]byte, error) {
+	return internal.MarshalJSONObject(d.Keys(), d.Values())
+}
+
+// UnmarshalJSON unmarshals a JSON object into the LFUDict, the pairs are put in the order they
+// appear in the JSON object. A zero value LFUDict takes the number of members as its capacity.
+func (d *LFUDict[K, V]) UnmarshalJSON(b []byte) error {
+	keys := make([]K, 0)
+	values := make([]V, 0)
+	if err := internal.UnmarshalJSONObject(b, func(k K, v V) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}); err != nil {
+		return err
+	}
+
+	if d.entries == nil {
+		if len(keys) == 0 {
+			return collection.ErrInvalidCapacity
+		}
+		*d = *NewLFUDict[K, V](len(keys))
+	}
+
+	d.Clear()
+	for i, k := range keys {
+		if e, ok := d.entries[k]; ok {
+			e.value = values[i]
+			continue
+		}
+		if d.Size() >= d.capacity {
+			d.removeEldest()
+		}
+		d.add(k, values[i], d.bucket(1, nil))
+	}
+
+	return nil
+}
+
+// add adds a new pair to the back of the bucket with the frequency of the bucket.
+func (d *LFUDict[K, V]) add(k K, v V, b *lfuBucket[K, V]) {
+	e := &entry[K, V]{key: k, value: v, freq: b.freq}
+	d.entries[k] = e
+	b.pushBack(e)
+}
+
+// bucket returns the bucket of the specified frequency. It creates the bucket after the prev bucket
+// if it does not exist, or at the front if prev is nil, so prev must be the bucket of the greatest
+// frequency that is less than the specified one.
+func (d *LFUDict[K, V]) bucket(freq int, prev *lfuBucket[K, V]) *lfuBucket[K, V] {
+	if b, ok := d.buckets[freq]; ok {
+		return b
+	}
+
+	b := &lfuBucket[K, V]{freq: freq, prev: prev}
+	if prev == nil {
+		b.next = d.head
+		d.head = b
+	} else {
+		b.next = prev.next
+		prev.next = b
+	}
+	if b.next != nil {
+		b.next.prev = b
+	}
+	d.buckets[freq] = b
+
+	return b
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations. It does not change the frequencies of the
+// entries, the value of a present key is updated in place, and a new key is put by Put.
+func (d *LFUDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	var old V
+	e, ok := d.entries[k]
+	if ok {
+		old = e.value
+	}
+
+	v, keep := remapping(old, ok)
+	if !keep {
+		if ok {
+			d.Remove(k)
+		}
+		var zero V
+		return zero, false
+	}
+
+	if ok {
+		e.value = v
+	} else {
+		d.Put(k, v)
+	}
+
+	return v, true
+}
+
+// remove removes the entry from the dictionary and its bucket, the empty bucket is deleted.
+func (d *LFUDict[K, V]) remove(e *entry[K, V]) {
+	delete(d.entries, e.key)
+	d.unlink(e)
+}
+
+// removeEldest removes and returns the least recently used pair of the least frequently used
+// pairs.
+func (d *LFUDict[K, V]) removeEldest() *entry[K, V] {
+	e := d.head.entryList.head
+	d.remove(e)
+
+	return e
+}
+
+// snapshot returns all entries from the least frequently used one.
+func (d *LFUDict[K, V]) snapshot() []*entry[K, V] {
+	entries := make([]*entry[K, V], 0, len(d.entries))
+	for b := d.head; b != nil; b = b.next {
+		for e := b.entryList.head; e != nil; e = e.next {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+// touch increases the frequency of the entry, and moves it to the back of the next bucket.
+func (d *LFUDict[K, V]) touch(e *entry[K, V]) {
+	next := d.bucket(e.freq+1, d.buckets[e.freq])
+	d.unlink(e)
+
+	e.freq++
+	next.pushBack(e)
+}
+
+// unlink removes the entry from its bucket, and deletes the bucket if it becomes empty.
+func (d *LFUDict[K, V]) unlink(e *entry[K, V]) {
+	b := d.buckets[e.freq]
+	b.remove(e)
+	if b.size > 0 {
+		return
+	}
+
+	delete(d.buckets, e.freq)
+	if b.prev == nil {
+		d.head = b.next
+	} else {
+		b.prev.next = b.next
+	}
+	if b.next != nil {
+		b.next.prev = b.prev
+	}
+}
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"encoding/json"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestLFUDictCache(t *testing.T) {
+	a := assert.New(t)
+
+	testCache(a, func(capacity int) Cache[int, int] {
+		return NewLFUDict[int, int](capacity)
+	})
+}
+
+func TestLFUDict(t *testing.T) {
+	a := assert.New(t)
+	d := NewLFUDict[string, int](3)
+
+	evicted := []string{}
+	d.OnEvict(func(k string, v int) {
+		evicted = append(evicted, k)
+	})
+
+	d.Put("a", 1)
+	d.Put("b", 2)
+	d.Put("c", 3)
+	d.Get("a")
+	d.Get("a")
+	d.Get("b")
+	a.EqualNow(3, d.Frequency("a"))
+	a.EqualNow(2, d.Frequency("b"))
+	a.EqualNow(1, d.Frequency("c"))
+	a.EqualNow(0, d.Frequency("d"))
+	a.EqualNow([]string{"c", "b", "a"}, d.Keys())
+
+	d.Put("d", 4)
+	a.EqualNow([]string{"c"}, evicted)
+	d.Put("e", 5)
+	a.EqualNow([]string{"c", "d"}, evicted)
+
+	d.Peek("e")
+	a.EqualNow(1, d.Frequency("e"))
+	d.Replace("e", 50)
+	a.EqualNow(2, d.Frequency("e"))
+	d.Put("b", 20)
+	a.EqualNow(3, d.Frequency("b"))
+	a.EqualNow([]string{"e", "a", "b"}, d.Keys())
+	a.EqualNow([]int{50, 1, 20}, d.Values())
+	a.EqualNow("dict[e: 50 a: 1 b: 20]", d.String())
+
+	a.EqualNow(50, d.Remove("e"))
+	d.Put("f", 6)
+	d.Put("g", 7)
+	a.EqualNow([]string{"c", "d", "f"}, evicted)
+
+	d.Resize(1)
+	a.EqualNow([]string{"c", "d", "f", "g", "a"}, evicted)
+	a.EqualNow([]string{"b"}, d.Keys())
+
+	cloned := d.Clone().(*LFUDict[string, int])
+	a.EqualNow(3, cloned.Frequency("b"))
+	a.TrueNow(d.Equals(cloned))
+}
+
+func TestLFUDictRemoveMinFrequency(t *testing.T) {
+	a := assert.New(t)
+	d := NewLFUDict[int, int](2)
+
+	d.Put(1, 1)
+	d.Put(2, 2)
+	d.Get(2)
+	d.Get(2)
+	d.Remove(1)
+	d.Put(3, 3)
+	d.Get(3)
+	d.Put(4, 4)
+	keys := d.Keys()
+	sort.Ints(keys)
+	a.EqualNow([]int{2, 4}, keys)
+}
+
+func TestLFUDictComputeFrequency(t *testing.T) {
+	a := assert.New(t)
+	d := NewLFUDict[string, int](2)
+	d.Put("a", 1)
+	d.Put("b", 2)
+
+	for i := 0; i < 10; i++ {
+		d.PutIfAbsent("a", 10)
+		d.CompareAndSwap("a", 10, 20)
+	}
+	d.Compute("a", func(_ string, old int, _ bool) (int, bool) {
+		return old + 1, true
+	})
+	a.EqualNow(1, d.Frequency("a"))
+	v, _ := d.Peek("a")
+	a.EqualNow(2, v)
+
+	d.Put("c", 3)
+	a.NotTrueNow(d.ContainsKey("a"))
+	a.TrueNow(d.ContainsKey("b"))
+}
+
+func TestLFUDictBuckets(t *testing.T) {
+	a := assert.New(t)
+	d := NewLFUDict[int, int](20)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		k := r.Intn(40)
+		switch r.Intn(5) {
+		case 0:
+			d.Remove(k)
+		case 1:
+			d.Put(k, k)
+		default:
+			d.Get(k)
+		}
+		if i%1000 == 999 {
+			d.Resize(10 + r.Intn(20))
+		}
+
+		size, buckets, freq := 0, 0, 0
+		for b := d.head; b != nil; b = b.next {
+			a.TrueNow(b.freq > freq)
+			a.TrueNow(b.size > 0)
+			a.TrueNow(d.buckets[b.freq] == b)
+			if b.next != nil {
+				a.TrueNow(b.next.prev == b)
+			}
+			for e := b.entryList.head; e != nil; e = e.next {
+				a.EqualNow(b.freq, e.freq)
+			}
+			freq = b.freq
+			size += b.size
+			buckets++
+		}
+		a.EqualNow(d.Size(), size)
+		a.EqualNow(len(d.buckets), buckets)
+	}
+}
+
+func TestLFUDictJSON(t *testing.T) {
+	a := assert.New(t)
+	d := NewLFUDict[string, int](2)
+	d.Put("a", 1)
+	d.Put("b", 2)
+	d.Get("a")
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"b":2,"a":1}`, string(b))
+
+	var zero LFUDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), &zero))
+	a.EqualNow(3, zero.Capacity())
+	a.EqualNow([]string{"x", "y", "z"}, zero.Keys())
+
+	a.NilNow(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), d))
+	a.EqualNow([]string{"y", "z"}, d.Keys())
+}
@@ -0,0 +1,322 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/ghosind/collection"
+)
+
+// LockCache is a thread-safe wrapper of Cache based on Mutex. It uses an exclusive lock for the
+// reading methods too because Get and GetDefault change the state of the eviction policy and the
+// counters.
+type LockCache[K comparable, V any] struct {
+	data Cache[K, V]
+	mu   sync.Mutex
+}
+
+// NewLockCache creates a new LockCache that wraps the specified cache, the cache should not be used
+// directly after wrapping.
+func NewLockCache[K comparable, V any](data Cache[K, V]) *LockCache[K, V] {
+	d := new(LockCache[K, V])
+	d.data = data
+
+	return d
+}
+
+// Capacity returns the maximum number of entries of this cache.
+func (d *LockCache[K, V]) Capacity() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Capacity()
+}
+
+// Clear removes all key-value pairs in this cache.
+func (d *LockCache[K, V]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.data.Clear()
+}
+
+// Clone returns a copy of this cache.
+func (d *LockCache[K, V]) Clone() collection.Dict[K, V] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	cloned := d.data.Clone().(Cache[K, V])
+
+	return NewLockCache(cloned)
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *LockCache[K, V]) CompareAndDelete(k K, old V) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.CompareAndDelete(k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *LockCache[K, V]) CompareAndSwap(k K, old, new V) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.CompareAndSwap(k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *LockCache[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Compute(k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *LockCache[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.ComputeIfAbsent(k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *LockCache[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.ComputeIfPresent(k, remapping)
+}
+
+// ContainsKey returns true if this cache contains a key-value pair with the specified key.
+func (d *LockCache[K, V]) ContainsKey(k K) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.ContainsKey(k)
+}
+
+// Equals compares this cache with the object pass from parameter.
+func (d *LockCache[K, V]) Equals(o any) bool {
+	od, ok := o.(*LockCache[K, V])
+	if !ok {
+		return false
+	}
+	if od == d {
+		return true
+	}
+
+	// Compare with a copy of the other cache to hold only one lock at a time, locking both caches
+	// deadlocks when they are compared with each other concurrently.
+	od.mu.Lock()
+	other := od.data.Clone()
+	od.mu.Unlock()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Equals(other)
+}
+
+// ForEach performs the given handler for each key-value pairs in the cache until all pairs
+// have been processed or the handler returns an error. The handler must not call the methods of
+// this cache.
+func (d *LockCache[K, V]) ForEach(handler func(K, V) error) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.ForEach(handler)
+}
+
+// Get returns the value which associated to the specified key.
+func (d *LockCache[K, V]) Get(k K) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Get(k)
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this cache contains no pair with the key.
+func (d *LockCache[K, V]) GetDefault(k K, defaultVal V) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.GetDefault(k, defaultVal)
+}
+
+// Hits returns the number of the Get and GetDefault calls that found the key.
+func (d *LockCache[K, V]) Hits() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Hits()
+}
+
+// IsEmpty returns true if this cache is empty.
+func (d *LockCache[K, V]) IsEmpty() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.IsEmpty()
+}
+
+// Keys returns a slice that contains all the keys in this cache.
+func (d *LockCache[K, V]) Keys() []K {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Keys()
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *LockCache[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Merge(k, v, remapping)
+}
+
+// Misses returns the number of the Get and GetDefault calls that did not find the key.
+func (d *LockCache[K, V]) Misses() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Misses()
+}
+
+// OnEvict sets the callback that is called with the evicted pair when a pair is evicted. The
+// callback is called with the lock held, so it must not call the methods of this cache.
+func (d *LockCache[K, V]) OnEvict(handler func(K, V)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.data.OnEvict(handler)
+}
+
+// Peek returns the value which associated to the specified key without affecting the eviction
+// policy and the counters.
+func (d *LockCache[K, V]) Peek(k K) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Peek(k)
+}
+
+// Put associate the specified value with the specified key in this cache.
+func (d *LockCache[K, V]) Put(k K, v V) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Put(k, v)
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *LockCache[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.PutIfAbsent(k, v)
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *LockCache[K, V]) Remove(k K) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Remove(k)
+}
+
+// Replace replaces the value for the specified key only if it is currently in this cache.
+func (d *LockCache[K, V]) Replace(k K, v V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Replace(k, v)
+}
+
+// ResetStats resets the hit and miss counters to zero.
+func (d *LockCache[K, V]) ResetStats() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.data.ResetStats()
+}
+
+// Resize changes the maximum number of entries of this cache, and evicts the pairs by the
+// eviction policy if the cache has more pairs than the new capacity.
+func (d *LockCache[K, V]) Resize(capacity int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.data.Resize(capacity)
+}
+
+// Size returns the number of key-value pairs in this cache.
+func (d *LockCache[K, V]) Size() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Size()
+}
+
+// String returns the string representation of this cache.
+func (d *LockCache[K, V]) String() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.String()
+}
+
+// Values returns a slice that contains all the values in this cache.
+func (d *LockCache[K, V]) Values() []V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.Values()
+}
+
+// MarshalJSON marshals the cache as a JSON object.
+func (d *LockCache[K, V]) MarshalJSON() ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals a JSON object into the cache.
+func (d *LockCache[K, V]) UnmarshalJSON(b []byte) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.data.UnmarshalJSON(b)
+}
+
+// snapshot returns the keys and values of all key-value pairs in this cache, the values are in the
+// same order of the keys.
+func (d *LockCache[K, V]) snapshot() ([]K, []V) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	keys := make([]K, 0, d.data.Size())
+	values := make([]V, 0, d.data.Size())
+	d.data.ForEach(func(k K, v V) error {
+		keys = append(keys, k)
+		values = append(values, v)
+		return nil
+	})
+
+	return keys, values
+}
@@ -0,0 +1,49 @@
+//go:build go1.23
+
+package cache
+
+import "iter"
+
+// Iter returns an iterator of a snapshot of all key-value pairs in this cache, so the
+// changes of this cache during the iteration are not visible.
+func (d *LockCache[K, V]) Iter() iter.Seq2[K, V] {
+	keys, values := d.snapshot()
+
+	return func(yield func(K, V) bool) {
+		for i, k := range keys {
+			if !yield(k, values[i]) {
+				break
+			}
+		}
+	}
+}
+
+// KeysIter returns an iterator of a snapshot of all keys in this cache.
+func (d *LockCache[K, V]) KeysIter() iter.Seq[K] {
+	d.mu.Lock()
+	keys := d.data.Keys()
+	d.mu.Unlock()
+
+	return func(yield func(K) bool) {
+		for _, k := range keys {
+			if !yield(k) {
+				break
+			}
+		}
+	}
+}
+
+// ValuesIter returns an iterator of a snapshot of all values in this cache.
+func (d *LockCache[K, V]) ValuesIter() iter.Seq[V] {
+	d.mu.Lock()
+	values := d.data.Values()
+	d.mu.Unlock()
+
+	return func(yield func(V) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				break
+			}
+		}
+	}
+}
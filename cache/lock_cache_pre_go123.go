@@ -0,0 +1,35 @@
+//go:build !go1.23
+
+package cache
+
+// KeysIter returns a channel iterator of a snapshot of all keys in this cache.
+func (d *LockCache[K, V]) KeysIter() <-chan K {
+	d.mu.Lock()
+	keys := d.data.Keys()
+	d.mu.Unlock()
+
+	ch := make(chan K)
+	go func() {
+		for _, k := range keys {
+			ch <- k
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of a snapshot of all values in this cache.
+func (d *LockCache[K, V]) ValuesIter() <-chan V {
+	d.mu.Lock()
+	values := d.data.Values()
+	d.mu.Unlock()
+
+	ch := make(chan V)
+	go func() {
+		for _, v := range values {
+			ch <- v
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,373 @@
+package cache
+
+import (
+	"bytes"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// LRUDict is a Cache implementation that evicts the least recently used entry when a new entry is
+// put into the full dictionary. Get, GetDefault, Put and Replace mark the entry as the most recently
+// used one, and the other reading methods do not change the order. The compute operations like
+// PutIfAbsent and CompareAndSwap update the value of a present entry without changing the order.
+//
+// LRUDict is not thread-safe, and it must not be wrapped by dict.LockDict because Get modifies the
+// dictionary, use LockCache for concurrent usage. The dictionary must be created by NewLRUDict.
+type LRUDict[K comparable, V any] struct {
+	cacheBase[K, V]
+	entries map[K]*entry[K, V]
+	list    entryList[K, V]
+}
+
+// NewLRUDict creates a new LRUDict with the specified maximum number of entries. It panics if the
+// capacity is not positive.
+func NewLRUDict[K comparable, V any](capacity int) *LRUDict[K, V] {
+	d := new(LRUDict[K, V])
+	d.setCapacity(capacity)
+	d.entries = make(map[K]*entry[K, V])
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary, the eviction callback is not called.
+func (d *LRUDict[K, V]) Clear() {
+	d.entries = make(map[K]*entry[K, V])
+	d.list.clear()
+}
+
+// Clone returns a copy of this dictionary with the same capacity, order and eviction callback. The
+// hit and miss counters of the copy start from zero.
+func (d *LRUDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewLRUDict[K, V](d.capacity)
+	newDict.onEvict = d.onEvict
+
+	for e := d.list.head; e != nil; e = e.next {
+		newDict.add(e.key, e.value)
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *LRUDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *LRUDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *LRUDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *LRUDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *LRUDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key, it
+// does not change the order of the entries.
+func (d *LRUDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.entries[k]
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *LRUDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*LRUDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for k, e := range d.entries {
+		oe, ok := od.entries[k]
+		if !ok || !internal.Equal(e.value, oe.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary from the least
+// recently used one until all pairs have been processed or the handler returns an error.
+func (d *LRUDict[K, V]) ForEach(handler func(K, V) error) error {
+	for e := d.list.head; e != nil; {
+		next := e.next
+		if err := handler(e.key, e.value); err != nil {
+			return err
+		}
+		e = next
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key, and marks the pair as the most
+// recently used one.
+func (d *LRUDict[K, V]) Get(k K) (V, bool) {
+	e, ok := d.entries[k]
+	d.record(ok)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	d.list.moveToBack(e)
+
+	return e.value, true
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *LRUDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.Get(k)
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *LRUDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary from the least recently used
+// one.
+func (d *LRUDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d.entries))
+	for e := d.list.head; e != nil; e = e.next {
+		keys = append(keys, e.key)
+	}
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *LRUDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Peek returns the value which associated to the specified key without changing the order of the
+// entries and the counters.
+func (d *LRUDict[K, V]) Peek(k K) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// Put associate the specified value with the specified key in this dictionary, and marks the pair
+// as the most recently used one. The least recently used pair is evicted if the dictionary is full.
+func (d *LRUDict[K, V]) Put(k K, v V) V {
+	e, ok := d.entries[k]
+	if ok {
+		old := e.value
+		e.value = v
+		d.list.moveToBack(e)
+		return old
+	}
+
+	d.add(k, v)
+	d.evict()
+
+	var zero V
+	return zero
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *LRUDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key, the eviction callback is not called.
+func (d *LRUDict[K, V]) Remove(k K) V {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero
+	}
+
+	delete(d.entries, k)
+	d.list.remove(e)
+
+	return e.value
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary, and
+// marks the pair as the most recently used one.
+func (d *LRUDict[K, V]) Replace(k K, v V) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	old := e.value
+	e.value = v
+	d.list.moveToBack(e)
+
+	return old, true
+}
+
+// Resize changes the maximum number of entries of this dictionary, and evicts the least recently
+// used pairs if the dictionary has more pairs than the new capacity. It panics if the capacity is
+// not positive.
+func (d *LRUDict[K, V]) Resize(capacity int) {
+	d.setCapacity(capacity)
+	d.evict()
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *LRUDict[K, V]) Size() int {
+	return len(d.entries)
+}
+
+// String returns the string representation of this dictionary.
+func (d *LRUDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	for e := d.list.head; e != nil; e = e.next {
+		if e != d.list.head {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e.key))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(e.value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains all the values in this dictionary from the least recently
+// used one.
+func (d *LRUDict[K, V]) Values() []V {
+	values := make([]V, 0, len(d.entries))
+	for e := d.list.head; e != nil; e = e.next {
+		values = append(values, e.value)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the LRUDict as a JSON object, the members are in the order from the least
+// recently used pair.
+func (d *LRUDict[K, V]) MarshalJSON() ([]byte, error) {
+	return internal.MarshalJSONObject(d.Keys(), d.Values())
+}
+
+// UnmarshalJSON unmarshals a JSON object into the LRUDict, the pairs are put in the order they
+// appear in the JSON object, so the last ones are kept if the object has more members than the
+// capacity. A zero value LRUDict takes the number of members as its capacity.
+func (d *LRUDict[K, V]) UnmarshalJSON(b []byte) error {
+	keys := make([]K, 0)
+	values := make([]V, 0)
+	if err := internal.UnmarshalJSONObject(b, func(k K, v V) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}); err != nil {
+		return err
+	}
+
+	if d.entries == nil {
+		if len(keys) == 0 {
+			return collection.ErrInvalidCapacity
+		}
+		*d = *NewLRUDict[K, V](len(keys))
+	}
+
+	d.Clear()
+	for i, k := range keys {
+		if e, ok := d.entries[k]; ok {
+			e.value = values[i]
+			d.list.moveToBack(e)
+			continue
+		}
+		d.add(k, values[i])
+		if d.Size() > d.capacity {
+			d.removeEldest()
+		}
+	}
+
+	return nil
+}
+
+// add adds a new pair as the most recently used one.
+func (d *LRUDict[K, V]) add(k K, v V) {
+	e := &entry[K, V]{key: k, value: v}
+	d.entries[k] = e
+	d.list.pushBack(e)
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations. It does not change the order of the entries,
+// the value of a present key is updated in place, and a new key is put by Put.
+func (d *LRUDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	var old V
+	e, ok := d.entries[k]
+	if ok {
+		old = e.value
+	}
+
+	v, keep := remapping(old, ok)
+	if !keep {
+		if ok {
+			d.Remove(k)
+		}
+		var zero V
+		return zero, false
+	}
+
+	if ok {
+		e.value = v
+	} else {
+		d.Put(k, v)
+	}
+
+	return v, true
+}
+
+// evict evicts the least recently used pairs until the size is not greater than the capacity.
+func (d *LRUDict[K, V]) evict() {
+	for d.Size() > d.capacity {
+		d.evicted(d.removeEldest())
+	}
+}
+
+// removeEldest removes and returns the least recently used pair.
+func (d *LRUDict[K, V]) removeEldest() *entry[K, V] {
+	e := d.list.head
+	delete(d.entries, e.key)
+	d.list.remove(e)
+
+	return e
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package cache
+
+import "iter"
+
+// Iter returns an iterator of all key-value pairs in this dictionary from the least recently used
+// one.
+func (d *LRUDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := d.list.head; e != nil; e = e.next {
+			if !yield(e.key, e.value) {
+				break
+			}
+		}
+	}
+}
+
+// KeysIter returns an iterator of all keys in this dictionary from the least recently used one.
+func (d *LRUDict[K, V]) KeysIter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := d.list.head; e != nil; e = e.next {
+			if !yield(e.key) {
+				break
+			}
+		}
+	}
+}
+
+// ValuesIter returns an iterator of all values in this dictionary from the least recently used
+// one.
+func (d *LRUDict[K, V]) ValuesIter() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := d.list.head; e != nil; e = e.next {
+			if !yield(e.value) {
+				break
+			}
+		}
+	}
+}
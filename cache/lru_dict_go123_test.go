@@ -0,0 +1,48 @@
+//go:build go1.23
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestLRUDictIter(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, int](3)
+	d.Put(1, 10)
+	d.Put(2, 20)
+	d.Put(3, 30)
+	d.Get(1)
+
+	keys := []int{}
+	for k, v := range d.Iter() {
+		a.EqualNow(k*10, v)
+		keys = append(keys, k)
+	}
+	a.EqualNow([]int{2, 3, 1}, keys)
+
+	keys = []int{}
+	for k := range d.KeysIter() {
+		keys = append(keys, k)
+	}
+	a.EqualNow([]int{2, 3, 1}, keys)
+
+	values := []int{}
+	for v := range d.ValuesIter() {
+		values = append(values, v)
+	}
+	a.EqualNow([]int{20, 30, 10}, values)
+
+	for range d.Iter() {
+		// yield should returns false
+		break
+	}
+	for range d.KeysIter() {
+		break
+	}
+	for range d.ValuesIter() {
+		break
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !go1.23
+
+package cache
+
+// KeysIter returns a channel iterator of all keys in this dictionary from the least recently used
+// one.
+func (d *LRUDict[K, V]) KeysIter() <-chan K {
+	ch := make(chan K)
+	go func() {
+		for e := d.list.head; e != nil; e = e.next {
+			ch <- e.key
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary from the least recently
+// used one.
+func (d *LRUDict[K, V]) ValuesIter() <-chan V {
+	ch := make(chan V)
+	go func() {
+		for e := d.list.head; e != nil; e = e.next {
+			ch <- e.value
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,213 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func TestLRUDictCache(t *testing.T) {
+	a := assert.New(t)
+
+	testCache(a, func(capacity int) Cache[int, int] {
+		return NewLRUDict[int, int](capacity)
+	})
+}
+
+func TestLRUDict(t *testing.T) {
+	a := assert.New(t)
+
+	a.PanicOfNow(func() {
+		NewLRUDict[string, int](0)
+	}, collection.ErrInvalidCapacity)
+
+	d := NewLRUDict[string, int](3)
+	a.TrueNow(d.IsEmpty())
+	a.EqualNow(3, d.Capacity())
+
+	a.EqualNow(0, d.Put("a", 1))
+	a.EqualNow(0, d.Put("b", 2))
+	a.EqualNow(0, d.Put("c", 3))
+	a.EqualNow(3, d.Size())
+	a.EqualNow([]string{"a", "b", "c"}, d.Keys())
+	a.EqualNow([]int{1, 2, 3}, d.Values())
+	a.EqualNow("dict[a: 1 b: 2 c: 3]", d.String())
+
+	v, ok := d.Get("a")
+	a.TrueNow(ok)
+	a.EqualNow(1, v)
+	a.EqualNow([]string{"b", "c", "a"}, d.Keys())
+
+	a.EqualNow(0, d.Put("d", 4))
+	a.EqualNow(3, d.Size())
+	a.NotTrueNow(d.ContainsKey("b"))
+	a.EqualNow([]string{"c", "a", "d"}, d.Keys())
+
+	a.EqualNow(3, d.Put("c", 30))
+	a.EqualNow([]string{"a", "d", "c"}, d.Keys())
+
+	old, ok := d.Replace("a", 10)
+	a.TrueNow(ok)
+	a.EqualNow(1, old)
+	_, ok = d.Replace("b", 20)
+	a.NotTrueNow(ok)
+	a.EqualNow([]string{"d", "c", "a"}, d.Keys())
+
+	a.EqualNow(4, d.Remove("d"))
+	a.EqualNow(0, d.Remove("d"))
+	a.EqualNow(99, d.GetDefault("d", 99))
+	a.EqualNow([]string{"c", "a"}, d.Keys())
+
+	keys := []string{}
+	a.NilNow(d.ForEach(func(k string, v int) error {
+		keys = append(keys, k)
+		return nil
+	}))
+	a.EqualNow([]string{"c", "a"}, keys)
+
+	d.Clear()
+	a.TrueNow(d.IsEmpty())
+	d.Put("x", 1)
+	a.EqualNow([]string{"x"}, d.Keys())
+}
+
+func TestLRUDictOnEvict(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, string](2)
+
+	evicted := []int{}
+	d.OnEvict(func(k int, v string) {
+		a.EqualNow(fmt.Sprint(k), v)
+		evicted = append(evicted, k)
+	})
+
+	d.Put(1, "1")
+	d.Put(2, "2")
+	d.Put(1, "1")
+	d.Put(3, "3")
+	a.EqualNow([]int{2}, evicted)
+
+	d.Remove(1)
+	d.Clear()
+	a.EqualNow([]int{2}, evicted)
+
+	d.Put(4, "4")
+	d.Put(5, "5")
+	d.Put(6, "6")
+	a.EqualNow([]int{2, 4}, evicted)
+}
+
+func TestLRUDictPeek(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, int](2)
+	d.Put(1, 1)
+	d.Put(2, 2)
+
+	v, ok := d.Peek(1)
+	a.TrueNow(ok)
+	a.EqualNow(1, v)
+	_, ok = d.Peek(3)
+	a.NotTrueNow(ok)
+	a.EqualNow(uint64(0), d.Hits())
+	a.EqualNow(uint64(0), d.Misses())
+
+	d.Put(3, 3)
+	a.NotTrueNow(d.ContainsKey(1))
+	a.EqualNow([]int{2, 3}, d.Keys())
+}
+
+func TestLRUDictResize(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, int](4)
+	for i := 1; i <= 4; i++ {
+		d.Put(i, i)
+	}
+
+	evicted := []int{}
+	d.OnEvict(func(k, v int) {
+		evicted = append(evicted, k)
+	})
+
+	d.Resize(2)
+	a.EqualNow(2, d.Capacity())
+	a.EqualNow([]int{1, 2}, evicted)
+	a.EqualNow([]int{3, 4}, d.Keys())
+
+	d.Resize(3)
+	d.Put(5, 5)
+	a.EqualNow([]int{3, 4, 5}, d.Keys())
+
+	a.PanicOfNow(func() {
+		d.Resize(-1)
+	}, collection.ErrInvalidCapacity)
+}
+
+func TestLRUDictStats(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, int](2)
+	d.Put(1, 1)
+
+	d.Get(1)
+	d.Get(2)
+	d.GetDefault(1, 0)
+	d.GetDefault(3, 0)
+	d.Get(4)
+	d.ContainsKey(1)
+	a.EqualNow(uint64(2), d.Hits())
+	a.EqualNow(uint64(3), d.Misses())
+
+	d.ResetStats()
+	a.EqualNow(uint64(0), d.Hits())
+	a.EqualNow(uint64(0), d.Misses())
+}
+
+func TestLRUDictCloneAndEquals(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[int, int](2)
+	d.Put(1, 1)
+	d.Put(2, 2)
+	d.Get(1)
+
+	evicted := 0
+	d.OnEvict(func(int, int) {
+		evicted++
+	})
+
+	cloned := d.Clone().(*LRUDict[int, int])
+	a.TrueNow(d.Equals(cloned))
+	a.EqualNow(2, cloned.Capacity())
+	a.EqualNow([]int{2, 1}, cloned.Keys())
+
+	cloned.Put(3, 3)
+	a.EqualNow(1, evicted)
+	a.NotTrueNow(d.Equals(cloned))
+	a.EqualNow(2, d.Size())
+	a.NotTrueNow(d.Equals(NewLFUDict[int, int](2)))
+}
+
+func TestLRUDictJSON(t *testing.T) {
+	a := assert.New(t)
+	d := NewLRUDict[string, int](2)
+	d.Put("a", 1)
+	d.Put("b", 2)
+	d.Get("a")
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"b":2,"a":1}`, string(b))
+
+	a.NilNow(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), d))
+	a.EqualNow([]string{"y", "z"}, d.Keys())
+
+	var zero LRUDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"x":1,"y":2,"z":3}`), &zero))
+	a.EqualNow(3, zero.Capacity())
+	a.EqualNow([]string{"x", "y", "z"}, zero.Keys())
+
+	var empty LRUDict[string, int]
+	a.EqualNow(collection.ErrInvalidCapacity, json.Unmarshal([]byte(`{}`), &empty))
+	a.NotNilNow(json.Unmarshal([]byte(`[1]`), d))
+}
@@ -0,0 +1,269 @@
+package collector
+
+import (
+	"strings"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/dict"
+	"github.com/ghosind/collection/internal"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+)
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Collector accumulates the elements into a mutable container and transforms the container into
+// the final result. A collector can be used for several times, and it can be used as the
+// downstream collector of GroupingBy, PartitioningBy and Mapping.
+type Collector[T, R any] struct {
+	// supply creates a new container, and returns the functions to accumulate an element into the
+	// container and to get the result from the container.
+	supply func() (accumulate func(T), finish func() R)
+}
+
+// NewCollector creates a new collector by the supplier function that creates a new container, the
+// accumulator function that adds an element into the container and returns the container, and the
+// finisher function that transforms the container into the result.
+func NewCollector[T, A, R any](
+	supplier func() A,
+	accumulator func(container A, e T) A,
+	finisher func(container A) R,
+) *Collector[T, R] {
+	return newCollector(func() (func(T), func() R) {
+		container := supplier()
+		return func(e T) {
+				container = accumulator(container, e)
+			}, func() R {
+				return finisher(container)
+			}
+	})
+}
+
+// Collect performs the collector on the elements of the iterable, and returns the result.
+func Collect[T, R any](it collection.Iterable[T], c *Collector[T, R]) R {
+	accumulate, finish := c.supply()
+	each(it, accumulate)
+
+	return finish()
+}
+
+// Averaging returns a collector that produces the arithmetic mean of the numbers that are returned
+// by the mapper function for the elements. The result is 0 if there is no element.
+func Averaging[T any, N Number](mapper func(T) N) *Collector[T, float64] {
+	return newCollector(func() (func(T), func() float64) {
+		sum := float64(0)
+		count := 0
+		return func(e T) {
+				sum += float64(mapper(e))
+				count++
+			}, func() float64 {
+				if count == 0 {
+					return 0
+				}
+				return sum / float64(count)
+			}
+	})
+}
+
+// Counting returns a collector that counts the number of the elements.
+func Counting[T any]() *Collector[T, int] {
+	return newCollector(func() (func(T), func() int) {
+		count := 0
+		return func(T) {
+				count++
+			}, func() int {
+				return count
+			}
+	})
+}
+
+// GroupingBy returns a collector that groups the elements by the keys returned by the classifier
+// function, and collects the elements of each group by the downstream collector. The result is a
+// HashDict that maps the keys to the results of the downstream collector.
+func GroupingBy[T any, K comparable, R any](
+	classifier func(T) K,
+	downstream *Collector[T, R],
+) *Collector[T, *dict.HashDict[K, R]] {
+	return newCollector(func() (func(T), func() *dict.HashDict[K, R]) {
+		groups := make(map[K]func(T))
+		finishes := make(map[K]func() R)
+		return func(e T) {
+				k := classifier(e)
+				accumulate, ok := groups[k]
+				if !ok {
+					var finish func() R
+					accumulate, finish = downstream.supply()
+					groups[k] = accumulate
+					finishes[k] = finish
+				}
+				accumulate(e)
+			}, func() *dict.HashDict[K, R] {
+				d := dict.NewHashDict[K, R]()
+				for k, finish := range finishes {
+					d.Put(k, finish())
+				}
+				return d
+			}
+	})
+}
+
+// Joining returns a collector that concatenates the string representations of the elements with
+// the separator.
+func Joining[T any](sep string) *Collector[T, string] {
+	return newCollector(func() (func(T), func() string) {
+		var buf strings.Builder
+		first := true
+		return func(e T) {
+				if !first {
+					buf.WriteString(sep)
+				}
+				first = false
+				buf.WriteString(internal.ValueString(e))
+			}, func() string {
+				return buf.String()
+			}
+	})
+}
+
+// Mapping returns a collector that applies the mapper function to the elements before collecting
+// them by the downstream collector.
+func Mapping[T, U, R any](mapper func(T) U, downstream *Collector[U, R]) *Collector[T, R] {
+	return newCollector(func() (func(T), func() R) {
+		accumulate, finish := downstream.supply()
+		return func(e T) {
+			accumulate(mapper(e))
+		}, finish
+	})
+}
+
+// MaxBy returns a collector that produces the maximum element by the comparison function, the
+// first one is kept if there are several maximum elements. The result is the zero value if there is
+// no element.
+func MaxBy[T any](cmp func(a, b T) int) *Collector[T, T] {
+	return selectBy(func(acc, e T) bool {
+		return cmp(e, acc) > 0
+	})
+}
+
+// MinBy returns a collector that produces the minimum element by the comparison function, the
+// first one is kept if there are several minimum elements. The result is the zero value if there is
+// no element.
+func MinBy[T any](cmp func(a, b T) int) *Collector[T, T] {
+	return selectBy(func(acc, e T) bool {
+		return cmp(e, acc) < 0
+	})
+}
+
+// PartitioningBy returns a collector that partitions the elements by the predicate, and collects
+// the elements of each partition by the downstream collector. The result is a HashDict that always
+// contains both the true and the false keys.
+func PartitioningBy[T, R any](
+	predicate func(T) bool,
+	downstream *Collector[T, R],
+) *Collector[T, *dict.HashDict[bool, R]] {
+	return newCollector(func() (func(T), func() *dict.HashDict[bool, R]) {
+		matchedAccumulate, matchedFinish := downstream.supply()
+		unmatchedAccumulate, unmatchedFinish := downstream.supply()
+		return func(e T) {
+				if predicate(e) {
+					matchedAccumulate(e)
+				} else {
+					unmatchedAccumulate(e)
+				}
+			}, func() *dict.HashDict[bool, R] {
+				d := dict.NewHashDict[bool, R]()
+				d.Put(true, matchedFinish())
+				d.Put(false, unmatchedFinish())
+				return d
+			}
+	})
+}
+
+// Summing returns a collector that produces the sum of the numbers that are returned by the mapper
+// function for the elements.
+func Summing[T any, N Number](mapper func(T) N) *Collector[T, N] {
+	return newCollector(func() (func(T), func() N) {
+		var sum N
+		return func(e T) {
+				sum += mapper(e)
+			}, func() N {
+				return sum
+			}
+	})
+}
+
+// ToDict returns a collector that puts the keys and values returned by the key and value functions
+// into a HashDict. The merge function is called with the old and the new values if a key is
+// duplicated, and the later value replaces the old one if the merge function is nil.
+func ToDict[T any, K comparable, V any](
+	key func(T) K,
+	value func(T) V,
+	merge func(old, new V) V,
+) *Collector[T, *dict.HashDict[K, V]] {
+	return newCollector(func() (func(T), func() *dict.HashDict[K, V]) {
+		d := dict.NewHashDict[K, V]()
+		return func(e T) {
+				if merge == nil {
+					d.Put(key(e), value(e))
+				} else {
+					d.Merge(key(e), value(e), merge)
+				}
+			}, func() *dict.HashDict[K, V] {
+				return d
+			}
+	})
+}
+
+// ToList returns a collector that adds the elements into an ArrayList.
+func ToList[T any]() *Collector[T, *list.ArrayList[T]] {
+	return newCollector(func() (func(T), func() *list.ArrayList[T]) {
+		l := list.NewArrayList[T]()
+		return func(e T) {
+				l.Add(e)
+			}, func() *list.ArrayList[T] {
+				return l
+			}
+	})
+}
+
+// ToSet returns a collector that adds the elements into a HashSet.
+func ToSet[T comparable]() *Collector[T, *set.HashSet[T]] {
+	return newCollector(func() (func(T), func() *set.HashSet[T]) {
+		s := set.NewHashSet[T]()
+		return func(e T) {
+				s.Add(e)
+			}, func() *set.HashSet[T] {
+				return s
+			}
+	})
+}
+
+// newCollector creates a new collector with the function that creates a new container.
+func newCollector[T, R any](supply func() (func(T), func() R)) *Collector[T, R] {
+	c := new(Collector[T, R])
+	c.supply = supply
+
+	return c
+}
+
+// selectBy returns a collector that produces the element selected by the replace function, the
+// replace function returns true if the element should replace the current selected one.
+func selectBy[T any](replace func(acc, e T) bool) *Collector[T, T] {
+	return newCollector(func() (func(T), func() T) {
+		var acc T
+		found := false
+		return func(e T) {
+				if !found || replace(acc, e) {
+					acc = e
+					found = true
+				}
+			}, func() T {
+				return acc
+			}
+	})
+}
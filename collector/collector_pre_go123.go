@@ -0,0 +1,12 @@
+//go:build !go1.23
+
+package collector
+
+import "github.com/ghosind/collection"
+
+// each performs the handler for each element of the iterable.
+func each[T any](it collection.Iterable[T], handler func(T)) {
+	for e := range it.Iter() {
+		handler(e)
+	}
+}
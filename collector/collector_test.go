@@ -0,0 +1,194 @@
+package collector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/collection/stream"
+	"github.com/ghosind/go-assert"
+)
+
+type employee struct {
+	name   string
+	dept   string
+	salary int
+}
+
+var employees = list.NewArrayListFrom(
+	employee{"Alice", "dev", 120},
+	employee{"Bob", "dev", 100},
+	employee{"Carol", "ops", 90},
+	employee{"Dave", "sales", 80},
+	employee{"Eve", "ops", 110},
+)
+
+func employeeName(e employee) string {
+	return e.name
+}
+
+func employeeDept(e employee) string {
+	return e.dept
+}
+
+func employeeSalary(e employee) int {
+	return e.salary
+}
+
+func compareSalary(a, b employee) int {
+	return a.salary - b.salary
+}
+
+func TestCollect(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow([]int{3, 1, 2, 1}, Collect[int](list.NewArrayListFrom(3, 1, 2, 1), ToList[int]()).ToSlice())
+
+	s := Collect[int](list.NewArrayListFrom(3, 1, 2, 1), ToSet[int]())
+	a.EqualNow(3, s.Size())
+	a.TrueNow(s.ContainsAll(1, 2, 3))
+
+	a.EqualNow(5, Collect[employee](employees, Counting[employee]()))
+	a.EqualNow(0, Collect[int](list.NewArrayList[int](), Counting[int]()))
+	a.EqualNow(500, Collect[employee](employees, Summing(employeeSalary)))
+	a.EqualNow(float64(100), Collect[employee](employees, Averaging(employeeSalary)))
+	a.EqualNow(float64(0), Collect[employee](list.NewArrayList[employee](), Averaging(employeeSalary)))
+
+	a.EqualNow(1.5, Collect[float32](list.NewArrayListFrom[float32](1, 2), Averaging(func(e float32) float32 {
+		return e
+	})))
+}
+
+func TestCollectStream(t *testing.T) {
+	a := assert.New(t)
+
+	names := Collect[string](
+		stream.Map(stream.From[employee](employees).Filter(func(e employee) bool {
+			return e.salary >= 100
+		}), employeeName),
+		Joining[string](", "),
+	)
+	a.EqualNow("Alice, Bob, Eve", names)
+}
+
+func TestJoining(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow("1-2-3", Collect[int](list.NewArrayListFrom(1, 2, 3), Joining[int]("-")))
+	a.EqualNow("a", Collect[string](list.NewArrayListFrom("a"), Joining[string](", ")))
+	a.EqualNow("", Collect[int](list.NewArrayList[int](), Joining[int](", ")))
+}
+
+func TestMinMaxBy(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow("Alice", Collect[employee](employees, MaxBy(compareSalary)).name)
+	a.EqualNow("Dave", Collect[employee](employees, MinBy(compareSalary)).name)
+	a.EqualNow(employee{}, Collect[employee](list.NewArrayList[employee](), MinBy(compareSalary)))
+
+	first := Collect[string](list.NewArrayListFrom("bb", "aa", "c"), MaxBy(func(a, b string) int {
+		return len(a) - len(b)
+	}))
+	a.EqualNow("bb", first)
+}
+
+func TestToDict(t *testing.T) {
+	a := assert.New(t)
+
+	d := Collect[employee](employees, ToDict(employeeDept, employeeSalary, func(old, new int) int {
+		return old + new
+	}))
+	a.EqualNow(3, d.Size())
+	a.EqualNow(220, d.GetDefault("dev", 0))
+	a.EqualNow(200, d.GetDefault("ops", 0))
+	a.EqualNow(80, d.GetDefault("sales", 0))
+
+	d = Collect[employee](employees, ToDict(employeeDept, employeeSalary, nil))
+	a.EqualNow(100, d.GetDefault("dev", 0))
+	a.EqualNow(110, d.GetDefault("ops", 0))
+}
+
+func TestGroupingBy(t *testing.T) {
+	a := assert.New(t)
+
+	groups := Collect[employee](employees, GroupingBy(employeeDept, Mapping(employeeName, ToList[string]())))
+	a.EqualNow(3, groups.Size())
+	a.EqualNow([]string{"Alice", "Bob"}, groups.GetDefault("dev", nil).ToSlice())
+	a.EqualNow([]string{"Carol", "Eve"}, groups.GetDefault("ops", nil).ToSlice())
+	a.EqualNow([]string{"Dave"}, groups.GetDefault("sales", nil).ToSlice())
+
+	counts := Collect[employee](employees, GroupingBy(employeeDept, Counting[employee]()))
+	a.EqualNow(2, counts.GetDefault("dev", 0))
+	a.EqualNow(1, counts.GetDefault("sales", 0))
+
+	nested := Collect[employee](employees, GroupingBy(
+		func(e employee) bool {
+			return e.salary >= 100
+		},
+		GroupingBy(employeeDept, Summing(employeeSalary)),
+	))
+	a.EqualNow(220, nested.GetDefault(true, nil).GetDefault("dev", 0))
+	a.EqualNow(110, nested.GetDefault(true, nil).GetDefault("ops", 0))
+	a.EqualNow(90, nested.GetDefault(false, nil).GetDefault("ops", 0))
+
+	a.TrueNow(Collect[employee](list.NewArrayList[employee](), GroupingBy(employeeDept, Counting[employee]())).IsEmpty())
+}
+
+func TestPartitioningBy(t *testing.T) {
+	a := assert.New(t)
+
+	parts := Collect[employee](employees, PartitioningBy(func(e employee) bool {
+		return e.dept == "dev"
+	}, Mapping(employeeName, Joining[string](","))))
+	a.EqualNow(2, parts.Size())
+	a.EqualNow("Alice,Bob", parts.GetDefault(true, ""))
+	a.EqualNow("Carol,Dave,Eve", parts.GetDefault(false, ""))
+
+	empty := Collect[int](list.NewArrayList[int](), PartitioningBy(func(e int) bool {
+		return e > 0
+	}, ToSet[int]()))
+	a.EqualNow(2, empty.Size())
+	a.TrueNow(empty.GetDefault(true, nil).IsEmpty())
+	a.TrueNow(empty.GetDefault(false, nil).IsEmpty())
+}
+
+func TestNewCollector(t *testing.T) {
+	a := assert.New(t)
+
+	upper := NewCollector(
+		func() *strings.Builder {
+			return new(strings.Builder)
+		},
+		func(b *strings.Builder, e string) *strings.Builder {
+			b.WriteString(strings.ToUpper(e))
+			return b
+		},
+		func(b *strings.Builder) string {
+			return b.String()
+		},
+	)
+	a.EqualNow("ABC", Collect[string](list.NewArrayListFrom("a", "b", "c"), upper))
+
+	distinct := NewCollector(
+		func() *set.HashSet[int] {
+			return set.NewHashSet[int]()
+		},
+		func(s *set.HashSet[int], e int) *set.HashSet[int] {
+			s.Add(e)
+			return s
+		},
+		func(s *set.HashSet[int]) int {
+			return s.Size()
+		},
+	)
+	groups := Collect[int](list.NewArrayListFrom(1, 2, 2, 3, 4, 4), GroupingBy(func(e int) bool {
+		return e%2 == 0
+	}, distinct))
+	a.EqualNow(2, groups.GetDefault(true, 0))
+	a.EqualNow(2, groups.GetDefault(false, 0))
+
+	// the collector can be reused, and each collection starts with a new container.
+	a.EqualNow(3, Collect[int](list.NewArrayListFrom(1, 2, 3), distinct))
+	a.EqualNow(1, Collect[int](list.NewArrayListFrom(5), distinct))
+}
@@ -0,0 +1,105 @@
+package compare
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Comparator is a function that compares two values, it returns a negative number if a is less
+// than b, zero if a equals b, and a positive number if a is greater than b. A Comparator can be
+// used by all the ordered structures of the library like TreeSet, TreeDict and Stream.Sorted, and
+// its Less method can be used by PriorityQueue.
+type Comparator[T any] func(a, b T) int
+
+// CaseInsensitive compares the strings lexicographically by their Unicode code points while
+// ignoring the case differences, it does not allocate any memory.
+func CaseInsensitive(a, b string) int {
+	for a != "" && b != "" {
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		a, b = a[na:], b[nb:]
+		if ra == rb {
+			continue
+		}
+
+		ra, rb = unicode.ToUpper(ra), unicode.ToUpper(rb)
+		if ra == rb {
+			continue
+		}
+		ra, rb = unicode.ToLower(ra), unicode.ToLower(rb)
+		if ra != rb {
+			return int(ra) - int(rb)
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+// ComparingBy returns a comparator that compares the values by the keys returned by the key
+// function with the specified key comparator.
+func ComparingBy[T, K any](key func(T) K, cmp Comparator[K]) Comparator[T] {
+	return func(a, b T) int {
+		return cmp(key(a), key(b))
+	}
+}
+
+// NullsFirst returns a comparator of the pointers that considers nil to be less than any non-nil
+// pointer, and compares the values of the non-nil pointers by the specified comparator.
+func NullsFirst[T any](cmp Comparator[T]) Comparator[*T] {
+	return nulls(cmp, -1)
+}
+
+// NullsLast returns a comparator of the pointers that considers nil to be greater than any non-nil
+// pointer, and compares the values of the non-nil pointers by the specified comparator.
+func NullsLast[T any](cmp Comparator[T]) Comparator[*T] {
+	return nulls(cmp, 1)
+}
+
+// Reverse returns a comparator that imposes the reverse order of the specified comparator.
+func Reverse[T any](cmp Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		return cmp(b, a)
+	}
+}
+
+// Less returns true if a is less than b by the comparator, it can be used by PriorityQueue.
+func (c Comparator[T]) Less(a, b T) bool {
+	return c(a, b) < 0
+}
+
+// Reversed returns a comparator that imposes the reverse order of the comparator.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return Reverse(c)
+}
+
+// ThenComparing returns a comparator that compares the values by the comparator first, and then by
+// the other comparators in order if the values are equal.
+func (c Comparator[T]) ThenComparing(others ...Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		for _, other := range others {
+			if r := other(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// nulls returns a comparator of the pointers that returns the specified result if only a is nil.
+func nulls[T any](cmp Comparator[T], nilResult int) Comparator[*T] {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return nilResult
+		case b == nil:
+			return -nilResult
+		default:
+			return cmp(*a, *b)
+		}
+	}
+}
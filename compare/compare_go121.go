@@ -0,0 +1,16 @@
+//go:build go1.21
+
+package compare
+
+import "cmp"
+
+// Comparing returns a comparator that compares the values by the keys returned by the key function
+// in their natural order.
+func Comparing[T any, K cmp.Ordered](key func(T) K) Comparator[T] {
+	return ComparingBy(key, cmp.Compare[K])
+}
+
+// Natural returns a comparator that compares the values in their natural order.
+func Natural[T cmp.Ordered]() Comparator[T] {
+	return cmp.Compare[T]
+}
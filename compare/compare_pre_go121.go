@@ -0,0 +1,16 @@
+//go:build !go1.21
+
+package compare
+
+import "github.com/ghosind/collection/internal"
+
+// Comparing returns a comparator that compares the values by the keys returned by the key function
+// in their natural order.
+func Comparing[T any, K internal.Ordered](key func(T) K) Comparator[T] {
+	return ComparingBy(key, internal.Compare[K])
+}
+
+// Natural returns a comparator that compares the values in their natural order.
+func Natural[T internal.Ordered]() Comparator[T] {
+	return internal.Compare[T]
+}
@@ -0,0 +1,134 @@
+package compare
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ghosind/collection/dict"
+	"github.com/ghosind/collection/queue"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/collection/stream"
+	"github.com/ghosind/go-assert"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+var people = []person{
+	{"Carol", 30},
+	{"alice", 25},
+	{"Bob", 30},
+	{"dave", 25},
+}
+
+func sortedBy[T any](elements []T, cmp Comparator[T]) []T {
+	sorted := make([]T, len(elements))
+	copy(sorted, elements)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return cmp.Less(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+func TestNatural(t *testing.T) {
+	a := assert.New(t)
+
+	a.TrueNow(Natural[int]()(1, 2) < 0)
+	a.EqualNow(0, Natural[string]()("a", "a"))
+	a.TrueNow(Natural[float64]()(2.5, 1) > 0)
+	a.EqualNow([]int{1, 2, 3}, sortedBy([]int{3, 1, 2}, Natural[int]()))
+	a.EqualNow([]int{3, 2, 1}, sortedBy([]int{3, 1, 2}, Natural[int]().Reversed()))
+	a.EqualNow([]int{3, 2, 1}, sortedBy([]int{3, 1, 2}, Reverse(Natural[int]())))
+	a.EqualNow([]int{1, 2, 3}, sortedBy([]int{3, 1, 2}, Reverse(Natural[int]()).Reversed()))
+}
+
+func TestComparing(t *testing.T) {
+	a := assert.New(t)
+
+	byAge := Comparing(func(p person) int {
+		return p.age
+	})
+	byName := ComparingBy(func(p person) string {
+		return p.name
+	}, CaseInsensitive)
+
+	a.EqualNow([]person{{"alice", 25}, {"dave", 25}, {"Carol", 30}, {"Bob", 30}}, sortedBy(people, byAge))
+	a.EqualNow([]person{{"alice", 25}, {"Bob", 30}, {"Carol", 30}, {"dave", 25}}, sortedBy(people, byName))
+	a.EqualNow(
+		[]person{{"alice", 25}, {"dave", 25}, {"Bob", 30}, {"Carol", 30}},
+		sortedBy(people, byAge.ThenComparing(byName)),
+	)
+	a.EqualNow(
+		[]person{{"Bob", 30}, {"Carol", 30}, {"alice", 25}, {"dave", 25}},
+		sortedBy(people, byAge.Reversed().ThenComparing(byName)),
+	)
+	a.EqualNow(
+		[]person{{"Carol", 30}, {"Bob", 30}, {"dave", 25}, {"alice", 25}},
+		sortedBy(people, byAge.ThenComparing(byName).Reversed()),
+	)
+	a.EqualNow(people, sortedBy(people, Comparator[person](func(person, person) int {
+		return 0
+	}).ThenComparing()))
+}
+
+func TestCaseInsensitive(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(0, CaseInsensitive("Hello", "hELLO"))
+	a.EqualNow(0, CaseInsensitive("", ""))
+	a.EqualNow(0, CaseInsensitive("ΣΑΣ", "σας"))
+	a.TrueNow(CaseInsensitive("apple", "Banana") < 0)
+	a.TrueNow(CaseInsensitive("Banana", "apple") > 0)
+	a.TrueNow(CaseInsensitive("abc", "ABCD") < 0)
+	a.TrueNow(CaseInsensitive("ABCD", "abc") > 0)
+	a.EqualNow([]string{"a", "B", "c", "D"}, sortedBy([]string{"D", "c", "B", "a"}, CaseInsensitive))
+	a.EqualNow([]string{"D", "c", "B", "a"}, sortedBy([]string{"a", "B", "c", "D"}, Reverse(CaseInsensitive)))
+}
+
+func TestNulls(t *testing.T) {
+	a := assert.New(t)
+
+	one, two := 1, 2
+	values := []*int{&two, nil, &one, nil}
+
+	first := sortedBy(values, NullsFirst(Natural[int]()))
+	a.EqualNow([]*int{nil, nil, &one, &two}, first)
+
+	last := sortedBy(values, NullsLast(Natural[int]()))
+	a.EqualNow([]*int{&one, &two, nil, nil}, last)
+
+	last = sortedBy(values, NullsLast(Natural[int]().Reversed()))
+	a.EqualNow([]*int{&two, &one, nil, nil}, last)
+
+	a.EqualNow(0, NullsFirst(Natural[int]())(nil, nil))
+	a.EqualNow(0, NullsLast(Natural[int]())(&one, &one))
+}
+
+func TestComparatorWithCollections(t *testing.T) {
+	a := assert.New(t)
+
+	ts := set.NewTreeSetFrom(Reverse(Natural[int]()), 3, 1, 2)
+	a.EqualNow([]int{3, 2, 1}, ts.ToSlice())
+
+	td := dict.NewTreeDict[string, int](CaseInsensitive)
+	td.Put("b", 2)
+	td.Put("A", 1)
+	td.Put("B", 3)
+	a.EqualNow([]string{"A", "b"}, td.Keys())
+	a.EqualNow(3, td.GetDefault("b", 0))
+
+	pq := queue.NewPriorityQueueFrom(Natural[int]().Reversed().Less, 1, 3, 2)
+	v, ok := pq.Poll()
+	a.TrueNow(ok)
+	a.EqualNow(3, v)
+
+	byAge := Comparing(func(p person) int {
+		return p.age
+	})
+	a.EqualNow(
+		[]person{{"Carol", 30}, {"Bob", 30}, {"alice", 25}, {"dave", 25}},
+		stream.Of(people...).Sorted(byAge.Reversed()).ToSlice(),
+	)
+}
@@ -14,6 +14,34 @@ type Dict[K comparable, V any] interface {
 	// Clone returns a copy of this dictionary.
 	Clone() Dict[K, V]
 
+	// CompareAndDelete removes the key-value pair with the specified key if its value is equal to
+	// the old value, and returns true if the pair was removed.
+	CompareAndDelete(k K, old V) bool
+
+	// CompareAndSwap replaces the value for the specified key with the new value if its current
+	// value is equal to the old value, and returns true if the value was swapped.
+	CompareAndSwap(k K, old, new V) bool
+
+	// Compute computes the new value for the specified key by the remapping function, which
+	// receives the key, the current value and whether the key is present. The pair is removed if the
+	// remapping function returns false. It returns the new value and whether the key is present
+	// after the computation. The lock-free implementations like SyncDict may call the remapping
+	// function more than once if the value is modified concurrently, so it should have no side
+	// effects.
+	Compute(k K, remapping func(k K, v V, loaded bool) (V, bool)) (V, bool)
+
+	// ComputeIfAbsent associates the value computed by the mapping function with the specified key
+	// if the key is not present, and returns the current value for the key. The mapping function
+	// is called at most once.
+	ComputeIfAbsent(k K, mapping func(k K) V) V
+
+	// ComputeIfPresent replaces the value for the specified key with the value computed by the
+	// remapping function if the key is present, the pair is removed if the remapping function
+	// returns false. It returns the new value and whether the key is present after the computation.
+	// The lock-free implementations like SyncDict may call the remapping function more than once if
+	// the value is modified concurrently, so it should have no side effects.
+	ComputeIfPresent(k K, remapping func(k K, v V) (V, bool)) (V, bool)
+
 	// ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
 	ContainsKey(k K) bool
 
@@ -37,9 +65,21 @@ type Dict[K comparable, V any] interface {
 	// Keys returns a slice that contains all the keys in this dictionary.
 	Keys() []K
 
+	// Merge associates the specified value with the specified key if the key is not present, or
+	// replaces the current value with the result of the remapping function of the current value and
+	// the specified value. It returns the new value for the key. The lock-free implementations like
+	// SyncDict may call the remapping function more than once if the value is modified
+	// concurrently, so it should have no side effects.
+	Merge(k K, v V, remapping func(old, new V) V) V
+
 	// Put associate the specified value with the specified key in this dictionary.
 	Put(k K, v V) V
 
+	// PutIfAbsent associates the specified value with the specified key if the key is not present.
+	// It returns the current value and true if the key is present, or returns the specified value
+	// and false.
+	PutIfAbsent(k K, v V) (V, bool)
+
 	// Remove removes the key-value pair with the specified key.
 	Remove(k K) V
 
@@ -52,3 +92,85 @@ type Dict[K comparable, V any] interface {
 	// Values returns a slice that contains all the values in this dictionary.
 	Values() []V
 }
+
+// SortedDict is a dictionary that keeps its keys in ascending order.
+type SortedDict[K comparable, V any] interface {
+	Dict[K, V]
+	SortedDictIter[K, V]
+
+	// FirstEntry returns the key-value pair with the lowest key in this dictionary, or false if this
+	// dictionary is empty.
+	FirstEntry() (K, V, bool)
+
+	// FirstKey returns the lowest key in this dictionary, or false if this dictionary is empty.
+	FirstKey() (K, bool)
+
+	// HeadDict returns a dictionary of the key-value pairs whose keys are strictly less than toKey.
+	HeadDict(toKey K) SortedDict[K, V]
+
+	// LastEntry returns the key-value pair with the highest key in this dictionary, or false if this
+	// dictionary is empty.
+	LastEntry() (K, V, bool)
+
+	// LastKey returns the highest key in this dictionary, or false if this dictionary is empty.
+	LastKey() (K, bool)
+
+	// PollFirst removes and returns the key-value pair with the lowest key in this dictionary, or
+	// false if this dictionary is empty.
+	PollFirst() (K, V, bool)
+
+	// PollLast removes and returns the key-value pair with the highest key in this dictionary, or
+	// false if this dictionary is empty.
+	PollLast() (K, V, bool)
+
+	// SubDict returns a dictionary of the key-value pairs whose keys range from fromKey, inclusive,
+	// to toKey, exclusive.
+	SubDict(fromKey, toKey K) SortedDict[K, V]
+
+	// TailDict returns a dictionary of the key-value pairs whose keys are greater than or equal to
+	// fromKey.
+	TailDict(fromKey K) SortedDict[K, V]
+}
+
+// NavigableDict is a sorted dictionary that can search the closest keys of the given key.
+type NavigableDict[K comparable, V any] interface {
+	SortedDict[K, V]
+
+	// Ceiling returns the least key greater than or equal to the specified key, or false if there is
+	// no such key.
+	Ceiling(k K) (K, bool)
+
+	// Floor returns the greatest key less than or equal to the specified key, or false if there is
+	// no such key.
+	Floor(k K) (K, bool)
+
+	// Higher returns the least key strictly greater than the specified key, or false if there is no
+	// such key.
+	Higher(k K) (K, bool)
+
+	// Lower returns the greatest key strictly less than the specified key, or false if there is no
+	// such key.
+	Lower(k K) (K, bool)
+}
+
+// BiDict is a dictionary that preserves the uniqueness of its values as well as that of its keys,
+// so it can be viewed in the inverse direction.
+type BiDict[K comparable, V comparable] interface {
+	Dict[K, V]
+
+	// ContainsValue returns true if this dictionary contains a key-value pair with the specified
+	// value.
+	ContainsValue(v V) bool
+
+	// ForcePut associates the specified value with the specified key in this dictionary, and removes
+	// the existing pair with the same value if it is associated with another key.
+	ForcePut(k K, v V) V
+
+	// Inverse returns the inverse view of this dictionary that maps each value to its key. The
+	// view is backed by this dictionary, so the changes of either one are visible in the other.
+	Inverse() BiDict[V, K]
+
+	// TryPut associates the specified value with the specified key in this dictionary, and returns
+	// ErrDuplicateValue if the value is already associated with another key.
+	TryPut(k K, v V) (V, error)
+}
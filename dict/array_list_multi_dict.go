@@ -0,0 +1,42 @@
+package dict
+
+import (
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+)
+
+// ArrayListMultiDict is a MultiDict implementation that stores the values of each key in an
+// ArrayList, so a key can be associated with duplicate values and the values are kept in the
+// order they were put. The dictionary must be created by NewArrayListMultiDict.
+type ArrayListMultiDict[K comparable, V any] struct {
+	multiDict[K, V]
+}
+
+// NewArrayListMultiDict creates a new ArrayListMultiDict.
+func NewArrayListMultiDict[K comparable, V any]() *ArrayListMultiDict[K, V] {
+	d := new(ArrayListMultiDict[K, V])
+	d.init(func() collection.Collection[V] {
+		return list.NewArrayList[V]()
+	})
+
+	return d
+}
+
+// Clone returns a copy of this dictionary.
+func (d *ArrayListMultiDict[K, V]) Clone() collection.MultiDict[K, V] {
+	newDict := NewArrayListMultiDict[K, V]()
+	d.cloneTo(&newDict.multiDict)
+
+	return newDict
+}
+
+// Equals compares this dictionary with the object pass from parameter. Two dictionaries are equal
+// if they contain the same keys, and the values of each key are equal in the same order.
+func (d *ArrayListMultiDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*ArrayListMultiDict[K, V])
+	if !ok {
+		return false
+	}
+
+	return d.equals(&od.multiDict)
+}
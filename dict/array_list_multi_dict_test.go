@@ -0,0 +1,37 @@
+package dict
+
+import (
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func arrayListMultiDictConstructor() collection.MultiDict[string, int] {
+	return NewArrayListMultiDict[string, int]()
+}
+
+func TestArrayListMultiDict(t *testing.T) {
+	a := assert.New(t)
+
+	testMultiDict(a, arrayListMultiDictConstructor)
+}
+
+func TestArrayListMultiDictDuplicates(t *testing.T) {
+	a := assert.New(t)
+	d := NewArrayListMultiDict[string, int]()
+
+	a.TrueNow(d.PutAll("a", 2, 1, 2))
+	a.TrueNow(d.Put("a", 1))
+	a.EqualNow(4, d.Size())
+	a.EqualNow([]int{2, 1, 2, 1}, d.GetAll("a"))
+	a.EqualNow("multidict[a: [2 1 2 1]]", d.String())
+
+	other := NewArrayListMultiDict[string, int]()
+	other.PutAll("a", 1, 2, 2, 1)
+	a.NotTrueNow(d.Equals(other))
+
+	a.TrueNow(d.RemoveValue("a", 2))
+	a.EqualNow(2, d.Size())
+	a.EqualNow([]int{1, 1}, d.GetAll("a"))
+}
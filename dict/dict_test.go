@@ -7,6 +7,7 @@ import (
 	"math/rand"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ghosind/collection"
@@ -49,6 +50,11 @@ var (
 func testDict(a *assert.Assertion, constructor dictConstructor) {
 	testDictClear(a, constructor)
 	testDictClone(a, constructor)
+	testDictCompareAndDelete(a, constructor)
+	testDictCompareAndSwap(a, constructor)
+	testDictCompute(a, constructor)
+	testDictComputeIfAbsent(a, constructor)
+	testDictComputeIfPresent(a, constructor)
 	testDictContainsKey(a, constructor)
 	testDictEquals(a, constructor)
 	testDictForEach(a, constructor)
@@ -58,7 +64,9 @@ func testDict(a *assert.Assertion, constructor dictConstructor) {
 	testDictIter(a, constructor)
 	testDictKeys(a, constructor)
 	testDictKeysIter(a, constructor)
+	testDictMerge(a, constructor)
 	testDictPut(a, constructor)
+	testDictPutIfAbsent(a, constructor)
 	testDictRemove(a, constructor)
 	testDictReplace(a, constructor)
 	testDictSize(a, constructor)
@@ -84,6 +92,123 @@ func testDictClone(a *assert.Assertion, constructor dictConstructor) {
 	a.TrueNow(d1.Equals(d2))
 }
 
+func testDictCompareAndDelete(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		a.NotTrueNow(d.CompareAndDelete(k, v+"-new"))
+		a.TrueNow(d.ContainsKey(k))
+		a.TrueNow(d.CompareAndDelete(k, v))
+		a.NotTrueNow(d.ContainsKey(k))
+		a.NotTrueNow(d.CompareAndDelete(k, v))
+	}
+	a.TrueNow(d.IsEmpty())
+}
+
+func testDictCompareAndSwap(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		a.NotTrueNow(d.CompareAndSwap(k, v+"-new", v+"-old"))
+		a.EqualNow(v, d.GetDefault(k, ""))
+		a.TrueNow(d.CompareAndSwap(k, v, v+"-new"))
+		a.EqualNow(v+"-new", d.GetDefault(k, ""))
+	}
+	for k, v := range testDataZh {
+		a.NotTrueNow(d.CompareAndSwap(k, "", v))
+		a.NotTrueNow(d.ContainsKey(k))
+	}
+	a.EqualNow(d.Size(), len(testDataEn))
+}
+
+func testDictCompute(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		nv, ok := d.Compute(k, func(key, old string, loaded bool) (string, bool) {
+			a.EqualNow(k, key)
+			a.EqualNow(v, old)
+			a.TrueNow(loaded)
+			return old + "-new", true
+		})
+		a.TrueNow(ok)
+		a.EqualNow(v+"-new", nv)
+		a.EqualNow(v+"-new", d.GetDefault(k, ""))
+	}
+	for k, v := range testDataZh {
+		nv, ok := d.Compute(k, func(key, old string, loaded bool) (string, bool) {
+			a.NotTrueNow(loaded)
+			a.EqualNow("", old)
+			return v, true
+		})
+		a.TrueNow(ok)
+		a.EqualNow(v, nv)
+		a.EqualNow(v, d.GetDefault(k, ""))
+	}
+	a.EqualNow(d.Size(), len(testDataEn)+len(testDataZh))
+
+	for k := range testDataZh {
+		nv, ok := d.Compute(k, func(key, old string, loaded bool) (string, bool) {
+			return old, false
+		})
+		a.NotTrueNow(ok)
+		a.EqualNow("", nv)
+		a.NotTrueNow(d.ContainsKey(k))
+	}
+	a.EqualNow(d.Size(), len(testDataEn))
+
+	_, ok := d.Compute("not-exist", func(key, old string, loaded bool) (string, bool) {
+		return "", false
+	})
+	a.NotTrueNow(ok)
+	a.NotTrueNow(d.ContainsKey("not-exist"))
+}
+
+func testDictComputeIfAbsent(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		a.EqualNow(v, d.ComputeIfAbsent(k, func(key string) string {
+			a.TrueNow(false, "mapping should not be called for the present key")
+			return ""
+		}))
+	}
+	for k := range testDataZh {
+		a.EqualNow(k, d.ComputeIfAbsent(k, func(key string) string {
+			a.EqualNow(k, key)
+			return key
+		}))
+		a.EqualNow(k, d.GetDefault(k, ""))
+	}
+	a.EqualNow(d.Size(), len(testDataEn)+len(testDataZh))
+}
+
+func testDictComputeIfPresent(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		nv, ok := d.ComputeIfPresent(k, func(key, old string) (string, bool) {
+			a.EqualNow(k, key)
+			a.EqualNow(v, old)
+			return old + "-new", true
+		})
+		a.TrueNow(ok)
+		a.EqualNow(v+"-new", nv)
+		a.EqualNow(v+"-new", d.GetDefault(k, ""))
+	}
+	for k := range testDataZh {
+		_, ok := d.ComputeIfPresent(k, func(key, old string) (string, bool) {
+			a.TrueNow(false, "remapping should not be called for the absent key")
+			return old, true
+		})
+		a.NotTrueNow(ok)
+		a.NotTrueNow(d.ContainsKey(k))
+	}
+	for k := range testDataEn {
+		_, ok := d.ComputeIfPresent(k, func(key, old string) (string, bool) {
+			return old, false
+		})
+		a.NotTrueNow(ok)
+		a.NotTrueNow(d.ContainsKey(k))
+	}
+	a.TrueNow(d.IsEmpty())
+}
+
 func testDictContainsKey(a *assert.Assertion, constructor dictConstructor) {
 	d := constructor(testDataEn)
 	for k := range testDataEn {
@@ -190,6 +315,26 @@ func testDictKeys(a *assert.Assertion, constructor dictConstructor) {
 	}
 }
 
+func testDictMerge(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		a.EqualNow(v+"-new", d.Merge(k, "-new", func(old, val string) string {
+			a.EqualNow(v, old)
+			a.EqualNow("-new", val)
+			return old + val
+		}))
+		a.EqualNow(v+"-new", d.GetDefault(k, ""))
+	}
+	for k, v := range testDataZh {
+		a.EqualNow(v, d.Merge(k, v, func(old, val string) string {
+			a.TrueNow(false, "remapping should not be called for the absent key")
+			return old + val
+		}))
+		a.EqualNow(v, d.GetDefault(k, ""))
+	}
+	a.EqualNow(d.Size(), len(testDataEn)+len(testDataZh))
+}
+
 func testDictPut(a *assert.Assertion, constructor dictConstructor) {
 	d := constructor()
 	for k, v := range testDataEn {
@@ -199,6 +344,23 @@ func testDictPut(a *assert.Assertion, constructor dictConstructor) {
 	a.EqualNow(d.Size(), len(testDataEn))
 }
 
+func testDictPutIfAbsent(a *assert.Assertion, constructor dictConstructor) {
+	d := constructor(testDataEn)
+	for k, v := range testDataEn {
+		actual, loaded := d.PutIfAbsent(k, v+"-new")
+		a.TrueNow(loaded)
+		a.EqualNow(v, actual)
+		a.EqualNow(v, d.GetDefault(k, ""))
+	}
+	for k := range testDataZh {
+		actual, loaded := d.PutIfAbsent(k, k)
+		a.NotTrueNow(loaded)
+		a.EqualNow(k, actual)
+		a.EqualNow(k, d.GetDefault(k, ""))
+	}
+	a.EqualNow(d.Size(), len(testDataEn)+len(testDataZh))
+}
+
 func testDictRemove(a *assert.Assertion, constructor dictConstructor) {
 	d := constructor(testDataEn)
 	a.EqualNow(d.Size(), len(testDataEn))
@@ -297,6 +459,43 @@ func testDictJSON(a *assert.Assertion, constructor dictConstructor) {
 	a.NotNilNow(err)
 }
 
+func TestDictComputeConcurrent(t *testing.T) {
+	a := assert.New(t)
+	dicts := []collection.Dict[int, int]{
+		NewLockDict[int, int](NewHashDict[int, int]()),
+		NewSyncDict[int, int](),
+		NewShardedDict[int, int](4),
+	}
+
+	for _, d := range dicts {
+		wg := sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 1000; j++ {
+					d.Merge(j%10, 1, func(old, v int) int {
+						return old + v
+					})
+					d.ComputeIfAbsent(j, func(k int) int {
+						return k
+					})
+					d.Compute(-1, func(k, old int, loaded bool) (int, bool) {
+						return old + 1, true
+					})
+				}
+			}()
+		}
+		wg.Wait()
+
+		for i := 0; i < 10; i++ {
+			a.EqualNow(800, d.GetDefault(i, 0))
+		}
+		a.EqualNow(8000, d.GetDefault(-1, 0))
+		a.EqualNow(1001, d.Size())
+	}
+}
+
 func benchmarkDict_Get(b *testing.B, constructor dictConstructor, isParallel bool) {
 	d := constructor()
 
@@ -0,0 +1,540 @@
+package dict
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// Clock provides the current time for the dictionaries that depend on time, it can be replaced by
+// a fake clock to control the time in tests.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// systemClock is the Clock that returns the system time.
+type systemClock struct{}
+
+// Now returns the current local time.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// expiringEntry is a value of the ExpiringDict with its expiration time, the zero expiration time
+// means the value never expires.
+type expiringEntry[V any] struct {
+	value    V
+	expireAt time.Time
+}
+
+// isExpired returns true if the entry is expired at the specified time.
+func (e *expiringEntry[V]) isExpired(now time.Time) bool {
+	return !e.expireAt.IsZero() && !now.Before(e.expireAt)
+}
+
+// ExpiringDict is a thread-safe dictionary that the key-value pairs expire after their time to
+// live. The expired pairs are never returned, and they are removed lazily when the dictionary is
+// accessed, or periodically by the janitor goroutine started by StartJanitor. The dictionary must
+// be created by NewExpiringDict or NewExpiringDictWithClock, and it should be closed by Close if
+// the janitor is started.
+type ExpiringDict[K comparable, V any] struct {
+	entries map[K]*expiringEntry[V]
+	ttl     time.Duration
+	clock   Clock
+	mu      sync.Mutex
+	stop    chan struct{}
+	done    chan struct{}
+	// newTicker creates the ticker of the janitor, and returns its channel and the function to stop
+	// it. It is replaced in tests to drive the janitor without waiting.
+	newTicker func(interval time.Duration) (<-chan time.Time, func())
+}
+
+// NewExpiringDict creates a new ExpiringDict that uses the system clock, and the pairs put by Put
+// expire after the specified default time to live. The pairs never expire by default if the ttl is
+// not positive.
+func NewExpiringDict[K comparable, V any](ttl time.Duration) *ExpiringDict[K, V] {
+	return NewExpiringDictWithClock[K, V](ttl, systemClock{})
+}
+
+// NewExpiringDictWithClock creates a new ExpiringDict that uses the specified clock to get the
+// current time, the system clock is used if the clock is nil.
+func NewExpiringDictWithClock[K comparable, V any](ttl time.Duration, clock Clock) *ExpiringDict[K, V] {
+	if clock == nil {
+		clock = systemClock{}
+	}
+
+	d := new(ExpiringDict[K, V])
+	d.entries = make(map[K]*expiringEntry[V])
+	d.ttl = ttl
+	d.clock = clock
+	d.newTicker = newSystemTicker
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *ExpiringDict[K, V]) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.entries = make(map[K]*expiringEntry[V])
+}
+
+// Clone returns a copy of this dictionary with the same default time to live, clock and expiration
+// times of the pairs. The janitor of this dictionary is not started for the copy.
+func (d *ExpiringDict[K, V]) Clone() collection.Dict[K, V] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.removeExpired()
+
+	newDict := NewExpiringDictWithClock[K, V](d.ttl, d.clock)
+	for k, e := range d.entries {
+		newDict.entries[k] = &expiringEntry[V]{value: e.value, expireAt: e.expireAt}
+	}
+
+	return newDict
+}
+
+// Close stops the janitor goroutine and waits for it to exit, it does nothing if the janitor is
+// not running. The dictionary can still be used after closing.
+func (d *ExpiringDict[K, V]) Close() error {
+	d.mu.Lock()
+	stop, done := d.stop, d.done
+	d.stop = nil
+	d.done = nil
+	d.mu.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+
+	return nil
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *ExpiringDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *ExpiringDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *ExpiringDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *ExpiringDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *ExpiringDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains an unexpired key-value pair with the
+// specified key.
+func (d *ExpiringDict[K, V]) ContainsKey(k K) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	_, ok := d.get(k)
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter, the expiration times of the
+// pairs are not compared.
+func (d *ExpiringDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*ExpiringDict[K, V])
+	if !ok {
+		return false
+	}
+	if od == d {
+		return true
+	}
+
+	// Compare with a snapshot of the other dictionary to hold only one lock at a time, locking both
+	// dictionaries deadlocks when they are compared with each other concurrently.
+	keys, values := od.snapshot()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.removeExpired()
+
+	if len(d.entries) != len(keys) {
+		return false
+	}
+
+	for i, k := range keys {
+		e, ok := d.entries[k]
+		if !ok || !internal.Equal(e.value, values[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each unexpired key-value pairs in the dictionary until
+// all pairs have been processed or the handler returns an error. The handler runs on a snapshot of
+// the dictionary, so it can call the methods of this dictionary.
+func (d *ExpiringDict[K, V]) ForEach(handler func(K, V) error) error {
+	keys, values := d.snapshot()
+
+	for i, k := range keys {
+		if err := handler(k, values[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key, it never returns an expired value.
+func (d *ExpiringDict[K, V]) Get(k K) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.get(k)
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no unexpired pair with the key.
+func (d *ExpiringDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.Get(k)
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// IsEmpty returns true if this dictionary contains no unexpired pairs.
+func (d *ExpiringDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains the keys of all unexpired pairs in this dictionary.
+func (d *ExpiringDict[K, V]) Keys() []K {
+	keys, _ := d.snapshot()
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *ExpiringDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Put associate the specified value with the specified key in this dictionary, the pair expires
+// after the default time to live of this dictionary.
+func (d *ExpiringDict[K, V]) Put(k K, v V) V {
+	return d.PutWithTTL(k, v, d.ttl)
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *ExpiringDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// PutWithTTL associate the specified value with the specified key in this dictionary, the pair
+// expires after the specified time to live, and it never expires if the ttl is not positive. It
+// returns the previous unexpired value associated with the key.
+func (d *ExpiringDict[K, V]) PutWithTTL(k K, v V, ttl time.Duration) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	old, _ := d.get(k)
+	d.entries[k] = d.newEntry(v, ttl)
+
+	return old
+}
+
+// Remove removes the key-value pair with the specified key, and returns the unexpired value of the
+// removed pair.
+func (d *ExpiringDict[K, V]) Remove(k K) V {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	v, _ := d.get(k)
+	delete(d.entries, k)
+
+	return v
+}
+
+// RemoveExpired removes all expired key-value pairs in this dictionary, and returns the number of
+// the removed pairs.
+func (d *ExpiringDict[K, V]) RemoveExpired() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.removeExpired()
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary and
+// not expired, the expiration time of the pair is not changed.
+func (d *ExpiringDict[K, V]) Replace(k K, v V) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	old, ok := d.get(k)
+	if !ok {
+		return old, false
+	}
+	d.entries[k].value = v
+
+	return old, true
+}
+
+// Size returns the number of unexpired key-value pairs in this dictionary.
+func (d *ExpiringDict[K, V]) Size() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.removeExpired()
+
+	return len(d.entries)
+}
+
+// StartJanitor starts a goroutine that removes the expired pairs every interval until the context
+// is done or Close is called, the running janitor is stopped before starting the new one. It panics
+// with ErrInvalidInterval if the interval is not positive.
+func (d *ExpiringDict[K, V]) StartJanitor(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		panic(collection.ErrInvalidInterval)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	newTicker := d.newTicker
+	if newTicker == nil {
+		newTicker = newSystemTicker
+	}
+	tick, stopTicker := newTicker(interval)
+
+	d.mu.Lock()
+	oldStop, oldDone := d.stop, d.done
+	d.stop = stop
+	d.done = done
+	d.mu.Unlock()
+
+	if oldStop != nil {
+		close(oldStop)
+		<-oldDone
+	}
+
+	go func() {
+		defer close(done)
+		defer stopTicker()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-stop:
+				return
+			case <-tick:
+				d.RemoveExpired()
+			}
+		}
+	}()
+}
+
+// String returns the string representation of this dictionary.
+func (d *ExpiringDict[K, V]) String() string {
+	keys, values := d.snapshot()
+
+	buf := bytes.NewBufferString("dict[")
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(k))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(values[i]))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// TTL returns the remaining time to live of the pair with the specified key, the duration is zero
+// if the pair never expires. It returns false if this dictionary contains no unexpired pair with
+// the key.
+func (d *ExpiringDict[K, V]) TTL(k K) (time.Duration, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.get(k); !ok {
+		return 0, false
+	}
+
+	e := d.entries[k]
+	if e.expireAt.IsZero() {
+		return 0, true
+	}
+
+	return e.expireAt.Sub(d.clock.Now()), true
+}
+
+// Values returns a slice that contains the values of all unexpired pairs in this dictionary.
+func (d *ExpiringDict[K, V]) Values() []V {
+	_, values := d.snapshot()
+
+	return values
+}
+
+// MarshalJSON marshals the unexpired pairs of the ExpiringDict as a JSON object (map), the
+// expiration times are not included.
+func (d *ExpiringDict[K, V]) MarshalJSON() ([]byte, error) {
+	keys, values := d.snapshot()
+
+	m := make(map[K]V, len(keys))
+	for i, k := range keys {
+		m[k] = values[i]
+	}
+
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON unmarshals a JSON object into the ExpiringDict, the pairs expire after the default
+// time to live.
+func (d *ExpiringDict[K, V]) UnmarshalJSON(b []byte) error {
+	var tmp map[K]V
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	if d.clock == nil {
+		d.clock = systemClock{}
+	}
+	d.entries = make(map[K]*expiringEntry[V], len(tmp))
+	d.mu.Unlock()
+
+	for k, v := range tmp {
+		d.Put(k, v)
+	}
+
+	return nil
+}
+
+// compute computes the new value for the specified key by the remapping function under the lock,
+// it is the implementation of the atomic compute operations. The existing pair keeps its
+// expiration time, and the new pair expires after the default time to live.
+func (d *ExpiringDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	old, ok := d.get(k)
+	v, keep := remapping(old, ok)
+	if !keep {
+		delete(d.entries, k)
+		var zero V
+		return zero, false
+	}
+
+	if ok {
+		d.entries[k].value = v
+	} else {
+		d.entries[k] = d.newEntry(v, d.ttl)
+	}
+
+	return v, true
+}
+
+// get returns the value of the unexpired pair with the specified key, and removes the pair if it
+// is expired. The caller must hold the lock.
+func (d *ExpiringDict[K, V]) get(k K) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	if e.isExpired(d.clock.Now()) {
+		delete(d.entries, k)
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+// newEntry creates an entry of the value that expires after the specified time to live, it never
+// expires if the ttl is not positive.
+func (d *ExpiringDict[K, V]) newEntry(v V, ttl time.Duration) *expiringEntry[V] {
+	e := &expiringEntry[V]{value: v}
+	if ttl > 0 {
+		e.expireAt = d.clock.Now().Add(ttl)
+	}
+
+	return e
+}
+
+// removeExpired removes all expired pairs and returns the number of the removed pairs. The caller
+// must hold the lock.
+func (d *ExpiringDict[K, V]) removeExpired() int {
+	now := d.clock.Now()
+	count := 0
+
+	for k, e := range d.entries {
+		if e.isExpired(now) {
+			delete(d.entries, k)
+			count++
+		}
+	}
+
+	return count
+}
+
+// snapshot returns the keys and values of all unexpired pairs in this dictionary.
+func (d *ExpiringDict[K, V]) snapshot() ([]K, []V) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.removeExpired()
+
+	keys := make([]K, 0, len(d.entries))
+	values := make([]V, 0, len(d.entries))
+	for k, e := range d.entries {
+		keys = append(keys, k)
+		values = append(values, e.value)
+	}
+
+	return keys, values
+}
+
+// newSystemTicker creates a ticker of the system time, and returns its channel and the function to
+// stop it.
+func newSystemTicker(interval time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(interval)
+	return ticker.C, ticker.Stop
+}
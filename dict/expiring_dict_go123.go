@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of a snapshot of all unexpired key-value pairs in this dictionary.
+func (d *ExpiringDict[K, V]) Iter() iter.Seq2[K, V] {
+	keys, values := d.snapshot()
+
+	return func(yield func(K, V) bool) {
+		for i, k := range keys {
+			if !yield(k, values[i]) {
+				break
+			}
+		}
+	}
+}
+
+// KeysIter returns an iterator of a snapshot of all unexpired keys in this dictionary.
+func (d *ExpiringDict[K, V]) KeysIter() iter.Seq[K] {
+	keys, _ := d.snapshot()
+
+	return func(yield func(K) bool) {
+		for _, k := range keys {
+			if !yield(k) {
+				break
+			}
+		}
+	}
+}
+
+// ValuesIter returns an iterator of a snapshot of all unexpired values in this dictionary.
+func (d *ExpiringDict[K, V]) ValuesIter() iter.Seq[V] {
+	_, values := d.snapshot()
+
+	return func(yield func(V) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+//go:build !go1.23
+
+package dict
+
+// KeysIter returns a channel iterator of a snapshot of all unexpired keys in this dictionary.
+func (d *ExpiringDict[K, V]) KeysIter() <-chan K {
+	keys, _ := d.snapshot()
+
+	ch := make(chan K)
+	go func() {
+		for _, k := range keys {
+			ch <- k
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of a snapshot of all unexpired values in this dictionary.
+func (d *ExpiringDict[K, V]) ValuesIter() <-chan V {
+	_, values := d.snapshot()
+
+	ch := make(chan V)
+	go func() {
+		for _, v := range values {
+			ch <- v
+		}
+		close(ch)
+	}()
+	return ch
+}
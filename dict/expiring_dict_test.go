@@ -0,0 +1,255 @@
+package dict
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func expiringDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	d := NewExpiringDict[string, string](time.Hour)
+	if len(initData) > 0 {
+		for k, v := range initData[0] {
+			d.Put(k, v)
+		}
+	}
+	return d
+}
+
+func TestExpiringDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, expiringDictConstructor)
+}
+
+func TestExpiringDictExpiration(t *testing.T) {
+	a := assert.New(t)
+	clock := newFakeClock()
+	d := NewExpiringDictWithClock[string, int](time.Minute, clock)
+
+	d.Put("a", 1)
+	d.PutWithTTL("b", 2, 10*time.Second)
+	d.PutWithTTL("c", 3, 0)
+	a.EqualNow(3, d.Size())
+
+	ttl, ok := d.TTL("b")
+	a.TrueNow(ok)
+	a.EqualNow(10*time.Second, ttl)
+	ttl, ok = d.TTL("c")
+	a.TrueNow(ok)
+	a.EqualNow(time.Duration(0), ttl)
+
+	clock.Advance(10 * time.Second)
+	_, ok = d.Get("b")
+	a.NotTrueNow(ok)
+	a.NotTrueNow(d.ContainsKey("b"))
+	_, ok = d.TTL("b")
+	a.NotTrueNow(ok)
+	a.EqualNow(1, d.GetDefault("a", 0))
+	a.EqualNow(2, d.Size())
+
+	old, ok := d.Replace("a", 10)
+	a.TrueNow(ok)
+	a.EqualNow(1, old)
+	ttl, _ = d.TTL("a")
+	a.EqualNow(50*time.Second, ttl)
+
+	clock.Advance(time.Hour)
+	_, ok = d.Replace("a", 100)
+	a.NotTrueNow(ok)
+	a.EqualNow(0, d.Put("a", 1))
+	keys := d.Keys()
+	sort.Strings(keys)
+	a.EqualNow([]string{"a", "c"}, keys)
+	a.EqualNow(1, d.PutWithTTL("a", 2, time.Second))
+
+	clock.Advance(time.Second)
+	a.EqualNow(0, d.Remove("a"))
+	a.EqualNow(3, d.Remove("c"))
+	a.TrueNow(d.IsEmpty())
+}
+
+func TestExpiringDictRemoveExpired(t *testing.T) {
+	a := assert.New(t)
+	clock := newFakeClock()
+	d := NewExpiringDictWithClock[int, int](time.Second, clock)
+
+	for i := 0; i < 10; i++ {
+		d.PutWithTTL(i, i, time.Duration(i+1)*time.Second)
+	}
+
+	a.EqualNow(0, d.RemoveExpired())
+	clock.Advance(5 * time.Second)
+	a.EqualNow(5, d.RemoveExpired())
+	a.EqualNow(5, d.Size())
+	a.EqualNow(5, len(d.Values()))
+
+	cloned := d.Clone().(*ExpiringDict[int, int])
+	a.TrueNow(d.Equals(cloned))
+	clock.Advance(time.Second)
+	a.EqualNow(4, cloned.Size())
+	a.TrueNow(d.Equals(cloned))
+}
+
+// newFakeTicker replaces the ticker of the dictionary with the returned channel, the sent tick is
+// handled by the janitor before the next tick is received, so sending a tick twice waits for the
+// first one to be handled.
+func newFakeTicker[K comparable, V any](d *ExpiringDict[K, V]) (chan time.Time, *int32) {
+	tick := make(chan time.Time)
+	var started int32
+	d.newTicker = func(time.Duration) (<-chan time.Time, func()) {
+		atomic.AddInt32(&started, 1)
+		return tick, func() {}
+	}
+	return tick, &started
+}
+
+func TestExpiringDictJanitor(t *testing.T) {
+	a := assert.New(t)
+	clock := newFakeClock()
+	d := NewExpiringDictWithClock[int, int](time.Second, clock)
+	tick, _ := newFakeTicker(d)
+	defer d.Close()
+
+	d.Put(1, 1)
+	d.Put(2, 2)
+	d.StartJanitor(context.Background(), time.Minute)
+	tick <- time.Time{}
+	a.EqualNow(2, d.length())
+
+	clock.Advance(time.Second)
+	tick <- time.Time{}
+	tick <- time.Time{}
+	a.EqualNow(0, d.length())
+
+	a.NilNow(d.Close())
+	a.NilNow(d.Close())
+	select {
+	case tick <- time.Time{}:
+		a.TrueNow(false, "the janitor is still running after Close")
+	default:
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	d.StartJanitor(ctx, time.Minute)
+	cancel()
+	a.NilNow(d.Close())
+
+	a.PanicOfNow(func() {
+		d.StartJanitor(context.Background(), 0)
+	}, collection.ErrInvalidInterval)
+}
+
+func TestExpiringDictJanitorRestart(t *testing.T) {
+	a := assert.New(t)
+	clock := newFakeClock()
+	d := NewExpiringDictWithClock[int, int](time.Second, clock)
+	tick, started := newFakeTicker(d)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.StartJanitor(context.Background(), time.Minute)
+		}()
+	}
+	wg.Wait()
+	a.EqualNow(int32(8), atomic.LoadInt32(started))
+
+	// only the last janitor is running, and it is stopped by Close.
+	d.Put(1, 1)
+	clock.Advance(time.Second)
+	tick <- time.Time{}
+	tick <- time.Time{}
+	a.EqualNow(0, d.length())
+
+	a.NilNow(d.Close())
+	select {
+	case tick <- time.Time{}:
+		a.TrueNow(false, "a janitor is still running after Close")
+	default:
+	}
+}
+
+func TestExpiringDictEqualsConcurrently(t *testing.T) {
+	a := assert.New(t)
+	d1 := NewExpiringDict[int, int](time.Hour)
+	d2 := NewExpiringDict[int, int](time.Hour)
+	for i := 0; i < 100; i++ {
+		d1.Put(i, i)
+		d2.Put(i, i)
+	}
+
+	var wg sync.WaitGroup
+	for _, pair := range [][2]*ExpiringDict[int, int]{{d1, d2}, {d2, d1}} {
+		wg.Add(1)
+		go func(x, y *ExpiringDict[int, int]) {
+			defer wg.Done()
+			for i := 0; i < 10000; i++ {
+				a.TrueNow(x.Equals(y))
+			}
+		}(pair[0], pair[1])
+	}
+	wg.Wait()
+}
+
+func TestExpiringDictJSON(t *testing.T) {
+	a := assert.New(t)
+	clock := newFakeClock()
+	d := NewExpiringDictWithClock[string, int](time.Second, clock)
+	d.Put("a", 1)
+	d.PutWithTTL("b", 2, time.Minute)
+	clock.Advance(time.Second)
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"b":2}`, string(b))
+
+	a.NilNow(json.Unmarshal([]byte(`{"x":1}`), d))
+	a.EqualNow(1, d.Size())
+	clock.Advance(time.Second)
+	a.TrueNow(d.IsEmpty())
+
+	var zero ExpiringDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"x":1}`), &zero))
+	a.EqualNow(1, zero.GetDefault("x", 0))
+}
+
+// length returns the number of pairs in the dictionary including the expired ones.
+func (d *ExpiringDict[K, V]) length() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.entries)
+}
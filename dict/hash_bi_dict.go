@@ -0,0 +1,363 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// HashBiDict is a BiDict implementation based on two Golang builtin maps, one maps the keys to the
+// values and the other maps the values to the keys. The dictionary must be created by
+// NewHashBiDict or NewHashBiDictFrom.
+//
+// Put, Replace and the compute operations work like ForcePut, the existing pair with the same value
+// is removed if the value is associated with another key. Use TryPut to get ErrDuplicateValue
+// instead.
+type HashBiDict[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *HashBiDict[V, K]
+}
+
+// NewHashBiDict creates a new HashBiDict.
+func NewHashBiDict[K comparable, V comparable]() *HashBiDict[K, V] {
+	d := new(HashBiDict[K, V])
+	d.forward = make(map[K]V)
+	d.backward = make(map[V]K)
+
+	return d
+}
+
+// NewHashBiDictFrom creates a new HashBiDict from the given map. It returns ErrDuplicateValue if
+// the map has duplicate values.
+func NewHashBiDictFrom[K comparable, V comparable](m map[K]V) (*HashBiDict[K, V], error) {
+	d := NewHashBiDict[K, V]()
+	for k, v := range m {
+		if _, err := d.TryPut(k, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *HashBiDict[K, V]) Clear() {
+	// deletes the pairs from the maps instead of replacing them to keep the inverse view valid.
+	for k := range d.forward {
+		delete(d.forward, k)
+	}
+	for v := range d.backward {
+		delete(d.backward, v)
+	}
+}
+
+// Clone returns a copy of this dictionary.
+func (d *HashBiDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewHashBiDict[K, V]()
+	for k, v := range d.forward {
+		newDict.forward[k] = v
+		newDict.backward[v] = k
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *HashBiDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *HashBiDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *HashBiDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *HashBiDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *HashBiDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
+func (d *HashBiDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.forward[k]
+
+	return ok
+}
+
+// ContainsValue returns true if this dictionary contains a key-value pair with the specified value.
+func (d *HashBiDict[K, V]) ContainsValue(v V) bool {
+	_, ok := d.backward[v]
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *HashBiDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*HashBiDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for k, v := range d.forward {
+		ov, ok := od.forward[k]
+		if !ok || ov != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForcePut associates the specified value with the specified key in this dictionary, and removes
+// the existing pair with the same value if it is associated with another key. It returns the
+// previous value associated with the key.
+func (d *HashBiDict[K, V]) ForcePut(k K, v V) V {
+	if old, ok := d.forward[k]; ok && old == v {
+		return old
+	}
+
+	if other, found := d.backward[v]; found {
+		delete(d.forward, other)
+	}
+
+	return d.put(k, v)
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+// have been processed or the handler returns an error.
+func (d *HashBiDict[K, V]) ForEach(handler func(K, V) error) error {
+	for k, v := range d.forward {
+		if err := handler(k, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key.
+func (d *HashBiDict[K, V]) Get(k K) (V, bool) {
+	v, ok := d.forward[k]
+	return v, ok
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *HashBiDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.forward[k]
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// Inverse returns the inverse view of this dictionary that maps each value to its key. The view is
+// backed by this dictionary, so the changes of either one are visible in the other.
+func (d *HashBiDict[K, V]) Inverse() collection.BiDict[V, K] {
+	if d.inverse == nil {
+		d.inverse = &HashBiDict[V, K]{
+			forward:  d.backward,
+			backward: d.forward,
+			inverse:  d,
+		}
+	}
+
+	return d.inverse
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *HashBiDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary.
+func (d *HashBiDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d.forward))
+	for k := range d.forward {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *HashBiDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Put associates the specified value with the specified key in this dictionary like ForcePut, and
+// returns the previous value associated with the key. Use TryPut to keep the existing pair with the
+// same value.
+func (d *HashBiDict[K, V]) Put(k K, v V) V {
+	return d.ForcePut(k, v)
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *HashBiDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *HashBiDict[K, V]) Remove(k K) V {
+	v, ok := d.forward[k]
+	if ok {
+		delete(d.forward, k)
+		delete(d.backward, v)
+	}
+
+	return v
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary, and
+// removes the existing pair with the same value if it is associated with another key.
+func (d *HashBiDict[K, V]) Replace(k K, v V) (V, bool) {
+	if !d.ContainsKey(k) {
+		var zero V
+		return zero, false
+	}
+
+	return d.ForcePut(k, v), true
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *HashBiDict[K, V]) Size() int {
+	return len(d.forward)
+}
+
+// String returns the string representation of this dictionary.
+func (d *HashBiDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	count := 0
+	for k, v := range d.forward {
+		if count > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(k))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(v))
+		count++
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// TryPut associates the specified value with the specified key in this dictionary, and returns the
+// previous value associated with the key. It returns ErrDuplicateValue without changing this
+// dictionary if the value is already associated with another key.
+func (d *HashBiDict[K, V]) TryPut(k K, v V) (V, error) {
+	if old, ok := d.forward[k]; ok && old == v {
+		return old, nil
+	}
+
+	if d.ContainsValue(v) {
+		var zero V
+		return zero, collection.ErrDuplicateValue
+	}
+
+	return d.put(k, v), nil
+}
+
+// Values returns a slice that contains all the values in this dictionary.
+func (d *HashBiDict[K, V]) Values() []V {
+	values := make([]V, 0, len(d.backward))
+	for v := range d.backward {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the HashBiDict as a JSON object (map).
+func (d *HashBiDict[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.forward)
+}
+
+// UnmarshalJSON unmarshals a JSON object into the HashBiDict. It returns ErrDuplicateValue if the
+// object has duplicate values.
+func (d *HashBiDict[K, V]) UnmarshalJSON(b []byte) error {
+	var tmp map[K]V
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	backward := make(map[V]K, len(tmp))
+	for k, v := range tmp {
+		if _, ok := backward[v]; ok {
+			return collection.ErrDuplicateValue
+		}
+		backward[v] = k
+	}
+
+	if d.forward == nil {
+		d.forward = make(map[K]V, len(tmp))
+		d.backward = make(map[V]K, len(tmp))
+	}
+
+	d.Clear()
+	for k, v := range tmp {
+		d.forward[k] = v
+		d.backward[v] = k
+	}
+
+	return nil
+}
+
+// put associates the value with the key, the caller must ensure that the value is not associated
+// with another key.
+func (d *HashBiDict[K, V]) put(k K, v V) V {
+	old, ok := d.forward[k]
+	if ok {
+		delete(d.backward, old)
+	}
+
+	d.forward[k] = v
+	d.backward[v] = k
+
+	return old
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations. It removes the existing pair with the new value
+// if the value is associated with another key.
+func (d *HashBiDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	old, ok := d.forward[k]
+	v, keep := remapping(old, ok)
+	if !keep {
+		d.Remove(k)
+		var zero V
+		return zero, false
+	}
+
+	d.ForcePut(k, v)
+
+	return v, true
+}
@@ -0,0 +1,38 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of all key-value pairs in this dictionary.
+func (d *HashBiDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range d.forward {
+			if !yield(k, v) {
+				break
+			}
+		}
+	}
+}
+
+// KeysIter returns an iterator of all keys in this dictionary.
+func (d *HashBiDict[K, V]) KeysIter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range d.forward {
+			if !yield(k) {
+				break
+			}
+		}
+	}
+}
+
+// ValuesIter returns an iterator of all values in this dictionary.
+func (d *HashBiDict[K, V]) ValuesIter() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range d.backward {
+			if !yield(v) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !go1.23
+
+package dict
+
+// KeysIter returns a channel iterator of all keys in this dictionary.
+func (d *HashBiDict[K, V]) KeysIter() <-chan K {
+	ch := make(chan K)
+	go func() {
+		for k := range d.forward {
+			ch <- k
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary.
+func (d *HashBiDict[K, V]) ValuesIter() <-chan V {
+	ch := make(chan V)
+	go func() {
+		for v := range d.backward {
+			ch <- v
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,132 @@
+package dict
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func hashBiDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	if len(initData) == 0 || len(initData[0]) == 0 {
+		return NewHashBiDict[string, string]()
+	}
+	d, err := NewHashBiDictFrom(initData[0])
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func TestHashBiDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, hashBiDictConstructor)
+}
+
+func TestHashBiDictUniqueValues(t *testing.T) {
+	a := assert.New(t)
+	d := NewHashBiDict[int, string]()
+
+	a.EqualNow("", d.Put(1, "one"))
+	a.EqualNow("", d.Put(2, "two"))
+	a.EqualNow("one", d.Put(1, "one"))
+	a.TrueNow(d.ContainsValue("two"))
+	a.NotTrueNow(d.ContainsValue("three"))
+
+	_, err := d.TryPut(3, "one")
+	a.EqualNow(collection.ErrDuplicateValue, err)
+	a.EqualNow(2, d.Size())
+
+	old, err := d.TryPut(1, "uno")
+	a.NilNow(err)
+	a.EqualNow("one", old)
+	a.NotTrueNow(d.ContainsValue("one"))
+
+	a.EqualNow("", d.ForcePut(3, "two"))
+	a.NotTrueNow(d.ContainsKey(2))
+	a.EqualNow(2, d.Size())
+	a.EqualNow("two", d.ForcePut(3, "two"))
+
+	_, err = NewHashBiDictFrom(map[int]string{1: "a", 2: "a"})
+	a.EqualNow(collection.ErrDuplicateValue, err)
+}
+
+func TestHashBiDictDuplicateValues(t *testing.T) {
+	a := assert.New(t)
+	d := NewHashBiDict[int, string]()
+	d.Put(1, "one")
+	d.Put(2, "two")
+
+	a.EqualNow("", d.Put(3, "two"))
+	a.NotTrueNow(d.ContainsKey(2))
+	a.EqualNow(3, d.Inverse().GetDefault("two", 0))
+
+	old, ok := d.Replace(1, "two")
+	a.TrueNow(ok)
+	a.EqualNow("one", old)
+	a.NotTrueNow(d.ContainsKey(3))
+	a.EqualNow(1, d.Size())
+
+	d.Put(2, "two-2")
+	a.TrueNow(d.CompareAndSwap(2, "two-2", "two"))
+	a.EqualNow([]int{2}, d.Keys())
+	a.EqualNow("two", d.ComputeIfAbsent(4, func(int) string {
+		return "two"
+	}))
+	v, ok := d.Compute(4, func(_ int, old string, _ bool) (string, bool) {
+		return old, true
+	})
+	a.TrueNow(ok)
+	a.EqualNow("two", v)
+	a.EqualNow([]int{4}, d.Keys())
+	a.EqualNow(4, d.Inverse().GetDefault("two", 0))
+}
+
+func TestHashBiDictInverse(t *testing.T) {
+	a := assert.New(t)
+	d := NewHashBiDict[int, string]()
+	d.Put(1, "one")
+	d.Put(2, "two")
+
+	inv := d.Inverse()
+	a.TrueNow(inv.Inverse() == collection.BiDict[int, string](d))
+	a.TrueNow(d.Inverse() == inv)
+	k, ok := inv.Get("two")
+	a.TrueNow(ok)
+	a.EqualNow(2, k)
+
+	inv.Put("three", 3)
+	v, ok := d.Get(3)
+	a.TrueNow(ok)
+	a.EqualNow("three", v)
+
+	d.Remove(1)
+	a.NotTrueNow(inv.ContainsKey("one"))
+	a.EqualNow(2, inv.Size())
+
+	inv.ForcePut("deux", 3)
+	a.NotTrueNow(d.ContainsValue("three"))
+	a.EqualNow("deux", d.GetDefault(3, ""))
+
+	d.Clear()
+	a.TrueNow(inv.IsEmpty())
+	d.Put(4, "four")
+	a.EqualNow(4, inv.GetDefault("four", 0))
+}
+
+func TestHashBiDictJSON(t *testing.T) {
+	a := assert.New(t)
+	d := NewHashBiDict[string, int]()
+
+	a.NilNow(json.Unmarshal([]byte(`{"a":1,"b":2}`), d))
+	a.EqualNow(2, d.Size())
+	a.EqualNow("b", d.Inverse().GetDefault(2, ""))
+	a.EqualNow(collection.ErrDuplicateValue, json.Unmarshal([]byte(`{"a":1,"b":1}`), d))
+	a.EqualNow(2, d.Size())
+
+	var zero HashBiDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"a":1}`), &zero))
+	a.EqualNow("a", zero.Inverse().GetDefault(1, ""))
+}
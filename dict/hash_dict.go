@@ -40,6 +40,38 @@ func (m *HashDict[K, V]) Clone() collection.Dict[K, V] {
 	return &newDict
 }
 
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (m *HashDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(m.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (m *HashDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(m.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (m *HashDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(m.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (m *HashDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(m.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (m *HashDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(m.compute, k, remapping)
+}
+
 // ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
 func (m *HashDict[K, V]) ContainsKey(k K) bool {
 	_, ok := (*m)[k]
@@ -116,6 +148,13 @@ func (m *HashDict[K, V]) Keys() []K {
 	return keys
 }
 
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (m *HashDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(m.compute, k, v, remapping)
+}
+
 // Put associate the specified value with the specified key in this dictionary.
 func (m *HashDict[K, V]) Put(k K, v V) V {
 	old := (*m)[k]
@@ -124,6 +163,13 @@ func (m *HashDict[K, V]) Put(k K, v V) V {
 	return old
 }
 
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (m *HashDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(m.compute, k, v)
+}
+
 // Remove removes the key-value pair with the specified key.
 func (m *HashDict[K, V]) Remove(k K) V {
 	old := (*m)[k]
@@ -191,3 +237,19 @@ func (m *HashDict[K, V]) UnmarshalJSON(b []byte) error {
 	*m = HashDict[K, V](tmp)
 	return nil
 }
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations.
+func (m *HashDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	old, ok := (*m)[k]
+	v, keep := remapping(old, ok)
+	if !keep {
+		delete(*m, k)
+		var zero V
+		return zero, false
+	}
+
+	(*m)[k] = v
+
+	return v, true
+}
@@ -0,0 +1,42 @@
+package dict
+
+import (
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/set"
+)
+
+// HashSetMultiDict is a MultiDict implementation that stores the values of each key in a HashSet,
+// so the duplicate values of a key are ignored. The dictionary must be created by
+// NewHashSetMultiDict.
+type HashSetMultiDict[K comparable, V comparable] struct {
+	multiDict[K, V]
+}
+
+// NewHashSetMultiDict creates a new HashSetMultiDict.
+func NewHashSetMultiDict[K comparable, V comparable]() *HashSetMultiDict[K, V] {
+	d := new(HashSetMultiDict[K, V])
+	d.init(func() collection.Collection[V] {
+		return set.NewHashSet[V]()
+	})
+
+	return d
+}
+
+// Clone returns a copy of this dictionary.
+func (d *HashSetMultiDict[K, V]) Clone() collection.MultiDict[K, V] {
+	newDict := NewHashSetMultiDict[K, V]()
+	d.cloneTo(&newDict.multiDict)
+
+	return newDict
+}
+
+// Equals compares this dictionary with the object pass from parameter. Two dictionaries are equal
+// if they contain the same key-value pairs.
+func (d *HashSetMultiDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*HashSetMultiDict[K, V])
+	if !ok {
+		return false
+	}
+
+	return d.equals(&od.multiDict)
+}
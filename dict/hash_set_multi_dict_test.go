@@ -0,0 +1,32 @@
+package dict
+
+import (
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func hashSetMultiDictConstructor() collection.MultiDict[string, int] {
+	return NewHashSetMultiDict[string, int]()
+}
+
+func TestHashSetMultiDict(t *testing.T) {
+	a := assert.New(t)
+
+	testMultiDict(a, hashSetMultiDictConstructor)
+}
+
+func TestHashSetMultiDictDuplicates(t *testing.T) {
+	a := assert.New(t)
+	d := NewHashSetMultiDict[string, int]()
+
+	a.TrueNow(d.PutAll("a", 2, 1, 2))
+	a.NotTrueNow(d.Put("a", 1))
+	a.NotTrueNow(d.PutAll("a", 1, 2))
+	a.EqualNow(2, d.Size())
+
+	other := NewHashSetMultiDict[string, int]()
+	other.PutAll("a", 1, 2)
+	a.TrueNow(d.Equals(other))
+}
@@ -0,0 +1,420 @@
+package dict
+
+import (
+	"bytes"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// linkedHashDictEntry is a key-value pair in the LinkedHashDict, it is also a node of the doubly
+// linked list that keeps the order of the pairs.
+type linkedHashDictEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *linkedHashDictEntry[K, V]
+	next  *linkedHashDictEntry[K, V]
+}
+
+// LinkedHashDict is a hash table based dictionary that keeps a doubly linked list running through
+// all of its key-value pairs, so the pairs are iterated in the order they were inserted. In the
+// access-order mode, the pairs are iterated in the order they were last accessed, from the least
+// recently accessed to the most recently.
+//
+// In the access-order mode, Get, GetDefault, Put and Replace move the pair to the end of the list,
+// so the dictionary is modified by the reading methods and must not be read concurrently even if
+// it is wrapped by LockDict.
+type LinkedHashDict[K comparable, V any] struct {
+	entries     map[K]*linkedHashDictEntry[K, V]
+	head        *linkedHashDictEntry[K, V]
+	tail        *linkedHashDictEntry[K, V]
+	accessOrder bool
+}
+
+// NewLinkedHashDict creates a new LinkedHashDict in insertion-order mode.
+func NewLinkedHashDict[K comparable, V any]() *LinkedHashDict[K, V] {
+	d := new(LinkedHashDict[K, V])
+	d.entries = make(map[K]*linkedHashDictEntry[K, V])
+
+	return d
+}
+
+// NewAccessOrderLinkedHashDict creates a new LinkedHashDict in access-order mode.
+func NewAccessOrderLinkedHashDict[K comparable, V any]() *LinkedHashDict[K, V] {
+	d := NewLinkedHashDict[K, V]()
+	d.accessOrder = true
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *LinkedHashDict[K, V]) Clear() {
+	d.entries = make(map[K]*linkedHashDictEntry[K, V])
+	d.head = nil
+	d.tail = nil
+}
+
+// Clone returns a copy of this dictionary, the copy has the same order and mode as this dictionary.
+func (d *LinkedHashDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewLinkedHashDict[K, V]()
+	newDict.accessOrder = d.accessOrder
+
+	for e := d.head; e != nil; e = e.next {
+		newDict.addEntry(e.key, e.value)
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *LinkedHashDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *LinkedHashDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *LinkedHashDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *LinkedHashDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *LinkedHashDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
+func (d *LinkedHashDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.entries[k]
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *LinkedHashDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*LinkedHashDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for k, e := range d.entries {
+		oe, ok := od.entries[k]
+		if !ok {
+			return false
+		}
+
+		if !internal.Equal(e.value, oe.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FirstEntry returns the first key-value pair in this dictionary, or false if this dictionary is
+// empty. In the access-order mode, it is the least recently accessed pair.
+func (d *LinkedHashDict[K, V]) FirstEntry() (K, V, bool) {
+	return d.entryOf(d.head)
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary in order until all
+// pairs have been processed or the handler returns an error.
+func (d *LinkedHashDict[K, V]) ForEach(handler func(K, V) error) error {
+	for e := d.head; e != nil; {
+		next := e.next
+		if err := handler(e.key, e.value); err != nil {
+			return err
+		}
+		e = next
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key.
+func (d *LinkedHashDict[K, V]) Get(k K) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	d.afterAccess(e)
+
+	return e.value, true
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *LinkedHashDict[K, V]) GetDefault(k K, defaultVal V) V {
+	e, ok := d.entries[k]
+	if !ok {
+		return defaultVal
+	}
+	d.afterAccess(e)
+
+	return e.value
+}
+
+// IsAccessOrder returns true if this dictionary is in the access-order mode.
+func (d *LinkedHashDict[K, V]) IsAccessOrder() bool {
+	return d.accessOrder
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *LinkedHashDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary in order.
+func (d *LinkedHashDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d.entries))
+	for e := d.head; e != nil; e = e.next {
+		keys = append(keys, e.key)
+	}
+
+	return keys
+}
+
+// LastEntry returns the last key-value pair in this dictionary, or false if this dictionary is
+// empty. In the access-order mode, it is the most recently accessed pair.
+func (d *LinkedHashDict[K, V]) LastEntry() (K, V, bool) {
+	return d.entryOf(d.tail)
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *LinkedHashDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// PollFirst removes and returns the first key-value pair in this dictionary, or false if this
+// dictionary is empty.
+func (d *LinkedHashDict[K, V]) PollFirst() (K, V, bool) {
+	e := d.head
+	if e != nil {
+		d.removeEntry(e)
+	}
+
+	return d.entryOf(e)
+}
+
+// PollLast removes and returns the last key-value pair in this dictionary, or false if this
+// dictionary is empty.
+func (d *LinkedHashDict[K, V]) PollLast() (K, V, bool) {
+	e := d.tail
+	if e != nil {
+		d.removeEntry(e)
+	}
+
+	return d.entryOf(e)
+}
+
+// Put associate the specified value with the specified key in this dictionary. The order of the
+// existing key is not changed in the insertion-order mode.
+func (d *LinkedHashDict[K, V]) Put(k K, v V) V {
+	e, ok := d.entries[k]
+	if !ok {
+		d.addEntry(k, v)
+		var zero V
+		return zero
+	}
+
+	old := e.value
+	e.value = v
+	d.afterAccess(e)
+
+	return old
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *LinkedHashDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *LinkedHashDict[K, V]) Remove(k K) V {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero
+	}
+
+	d.removeEntry(e)
+
+	return e.value
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary.
+func (d *LinkedHashDict[K, V]) Replace(k K, v V) (V, bool) {
+	e, ok := d.entries[k]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	old := e.value
+	e.value = v
+	d.afterAccess(e)
+
+	return old, true
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *LinkedHashDict[K, V]) Size() int {
+	return len(d.entries)
+}
+
+// String returns the string representation of this dictionary.
+func (d *LinkedHashDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	for e := d.head; e != nil; e = e.next {
+		if e != d.head {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e.key))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(e.value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains all the values in this dictionary in order.
+func (d *LinkedHashDict[K, V]) Values() []V {
+	values := make([]V, 0, len(d.entries))
+	for e := d.head; e != nil; e = e.next {
+		values = append(values, e.value)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the LinkedHashDict as a JSON object, the members are in the order of this
+// dictionary.
+func (d *LinkedHashDict[K, V]) MarshalJSON() ([]byte, error) {
+	return internal.MarshalJSONObject(d.Keys(), d.Values())
+}
+
+// UnmarshalJSON unmarshals a JSON object into the LinkedHashDict, the pairs are inserted in the
+// order they appear in the JSON object.
+func (d *LinkedHashDict[K, V]) UnmarshalJSON(b []byte) error {
+	tmp := NewLinkedHashDict[K, V]()
+	tmp.accessOrder = d.accessOrder
+	if err := internal.UnmarshalJSONObject(b, func(k K, v V) {
+		tmp.Put(k, v)
+	}); err != nil {
+		return err
+	}
+
+	*d = *tmp
+	return nil
+}
+
+// addEntry adds a new pair to the end of the list.
+func (d *LinkedHashDict[K, V]) addEntry(k K, v V) {
+	e := &linkedHashDictEntry[K, V]{key: k, value: v}
+	d.entries[k] = e
+	d.linkLast(e)
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations.
+func (d *LinkedHashDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	var old V
+	e, ok := d.entries[k]
+	if ok {
+		old = e.value
+	}
+
+	v, keep := remapping(old, ok)
+	if !keep {
+		if ok {
+			d.removeEntry(e)
+		}
+		var zero V
+		return zero, false
+	}
+
+	if ok {
+		e.value = v
+		d.afterAccess(e)
+	} else {
+		d.addEntry(k, v)
+	}
+
+	return v, true
+}
+
+// afterAccess moves the accessed pair to the end of the list in the access-order mode.
+func (d *LinkedHashDict[K, V]) afterAccess(e *linkedHashDictEntry[K, V]) {
+	if !d.accessOrder || e == d.tail {
+		return
+	}
+
+	d.unlink(e)
+	d.linkLast(e)
+}
+
+// entryOf returns the key and value of the pair, or false if the pair is nil.
+func (d *LinkedHashDict[K, V]) entryOf(e *linkedHashDictEntry[K, V]) (K, V, bool) {
+	if e == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	return e.key, e.value, true
+}
+
+func (d *LinkedHashDict[K, V]) linkLast(e *linkedHashDictEntry[K, V]) {
+	e.prev = d.tail
+	e.next = nil
+	if d.tail == nil {
+		d.head = e
+	} else {
+		d.tail.next = e
+	}
+	d.tail = e
+}
+
+func (d *LinkedHashDict[K, V]) removeEntry(e *linkedHashDictEntry[K, V]) {
+	delete(d.entries, e.key)
+	d.unlink(e)
+}
+
+func (d *LinkedHashDict[K, V]) unlink(e *linkedHashDictEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		d.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		d.tail = e.prev
+	}
+	e.prev = nil
+	e.next = nil
+}
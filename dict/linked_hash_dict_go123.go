@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of all elements in this dictionary in order.
+func (d *LinkedHashDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := d.head; e != nil; {
+			next := e.next
+			if !yield(e.key, e.value) {
+				break
+			}
+			e = next
+		}
+	}
+}
+
+// KeysIter returns an iterator of all keys in this dictionary in order.
+func (d *LinkedHashDict[K, V]) KeysIter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := d.head; e != nil; {
+			next := e.next
+			if !yield(e.key) {
+				break
+			}
+			e = next
+		}
+	}
+}
+
+// ValuesIter returns an iterator of all values in this dictionary in order.
+func (d *LinkedHashDict[K, V]) ValuesIter() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := d.head; e != nil; {
+			next := e.next
+			if !yield(e.value) {
+				break
+			}
+			e = next
+		}
+	}
+}
@@ -0,0 +1,27 @@
+//go:build !go1.23
+
+package dict
+
+// KeysIter returns a channel iterator of all keys in this dictionary in order.
+func (d *LinkedHashDict[K, V]) KeysIter() <-chan K {
+	ch := make(chan K)
+	go func() {
+		for e := d.head; e != nil; e = e.next {
+			ch <- e.key
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary in order.
+func (d *LinkedHashDict[K, V]) ValuesIter() <-chan V {
+	ch := make(chan V)
+	go func() {
+		for e := d.head; e != nil; e = e.next {
+			ch <- e.value
+		}
+		close(ch)
+	}()
+	return ch
+}
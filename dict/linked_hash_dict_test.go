@@ -0,0 +1,155 @@
+package dict
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func linkedHashDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	d := NewLinkedHashDict[string, string]()
+	if len(initData) > 0 {
+		for k, v := range initData[0] {
+			d.Put(k, v)
+		}
+	}
+	return d
+}
+
+func TestLinkedHashDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, linkedHashDictConstructor)
+}
+
+func BenchmarkLinkedHashDict_Get(b *testing.B) {
+	benchmarkDict_Get(b, linkedHashDictConstructor, false)
+}
+
+func BenchmarkLinkedHashDict_Put(b *testing.B) {
+	benchmarkDict_Put(b, linkedHashDictConstructor, false)
+}
+
+func accessOrderLinkedHashDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	d := NewAccessOrderLinkedHashDict[string, string]()
+	if len(initData) > 0 {
+		for k, v := range initData[0] {
+			d.Put(k, v)
+		}
+	}
+	return d
+}
+
+func TestAccessOrderLinkedHashDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, accessOrderLinkedHashDictConstructor)
+}
+
+func TestLinkedHashDictInsertionOrder(t *testing.T) {
+	a := assert.New(t)
+	d := NewLinkedHashDict[int, string]()
+	a.NotTrueNow(d.IsAccessOrder())
+
+	d.Put(3, "c")
+	d.Put(1, "a")
+	d.Put(2, "b")
+	d.Put(10, "j")
+
+	a.EqualNow([]int{3, 1, 2, 10}, d.Keys())
+	a.EqualNow([]string{"c", "a", "b", "j"}, d.Values())
+	a.EqualNow("dict[3: c 1: a 2: b 10: j]", d.String())
+
+	// update and get should not change the insertion order
+	d.Put(1, "A")
+	d.Get(3)
+	a.EqualNow([]int{3, 1, 2, 10}, d.Keys())
+
+	d.Remove(1)
+	d.Put(1, "a")
+	a.EqualNow([]int{3, 2, 10, 1}, d.Keys())
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"3":"c","2":"b","10":"j","1":"a"}`, string(b))
+
+	d2 := NewLinkedHashDict[int, string]()
+	a.NilNow(json.Unmarshal(b, d2))
+	a.EqualNow(d.Keys(), d2.Keys())
+	a.TrueNow(d.Equals(d2))
+
+	clone := d.Clone().(*LinkedHashDict[int, string])
+	a.EqualNow(d.Keys(), clone.Keys())
+}
+
+func TestLinkedHashDictAccessOrder(t *testing.T) {
+	a := assert.New(t)
+	d := NewAccessOrderLinkedHashDict[int, string]()
+	a.TrueNow(d.IsAccessOrder())
+
+	d.Put(1, "a")
+	d.Put(2, "b")
+	d.Put(3, "c")
+	a.EqualNow([]int{1, 2, 3}, d.Keys())
+
+	d.Get(1)
+	a.EqualNow([]int{2, 3, 1}, d.Keys())
+
+	d.GetDefault(2, "")
+	a.EqualNow([]int{3, 1, 2}, d.Keys())
+
+	d.Put(3, "C")
+	a.EqualNow([]int{1, 2, 3}, d.Keys())
+
+	d.Replace(1, "A")
+	a.EqualNow([]int{2, 3, 1}, d.Keys())
+
+	// ContainsKey and missing keys should not change the order
+	d.ContainsKey(2)
+	d.Get(4)
+	a.EqualNow([]int{2, 3, 1}, d.Keys())
+
+	clone := d.Clone().(*LinkedHashDict[int, string])
+	a.TrueNow(clone.IsAccessOrder())
+	a.EqualNow(d.Keys(), clone.Keys())
+}
+
+func TestLinkedHashDictFirstLast(t *testing.T) {
+	a := assert.New(t)
+	d := NewLinkedHashDict[int, string]()
+
+	_, _, ok := d.FirstEntry()
+	a.NotTrueNow(ok)
+	_, _, ok = d.LastEntry()
+	a.NotTrueNow(ok)
+	_, _, ok = d.PollFirst()
+	a.NotTrueNow(ok)
+	_, _, ok = d.PollLast()
+	a.NotTrueNow(ok)
+
+	d.Put(2, "b")
+	d.Put(1, "a")
+	d.Put(3, "c")
+
+	k, v, ok := d.FirstEntry()
+	a.TrueNow(ok)
+	a.EqualNow(2, k)
+	a.EqualNow("b", v)
+
+	k, v, ok = d.LastEntry()
+	a.TrueNow(ok)
+	a.EqualNow(3, k)
+	a.EqualNow("c", v)
+
+	k, _, ok = d.PollFirst()
+	a.TrueNow(ok)
+	a.EqualNow(2, k)
+	k, _, ok = d.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(3, k)
+
+	a.EqualNow([]int{1}, d.Keys())
+	a.EqualNow(1, d.Size())
+}
@@ -9,13 +9,14 @@ import (
 // LockDict is a thread-safe dictionary that wraps another dictionary with read-write locks.
 type LockDict[K comparable, V any] struct {
 	data collection.Dict[K, V]
-	mu   sync.RWMutex
+	mu   *sync.RWMutex
 }
 
 // NewLockDict creates a new LockDict.
 func NewLockDict[K comparable, V any](data collection.Dict[K, V]) *LockDict[K, V] {
 	d := new(LockDict[K, V])
 	d.data = data
+	d.mu = new(sync.RWMutex)
 
 	return d
 }
@@ -38,6 +39,53 @@ func (m *LockDict[K, V]) Clone() collection.Dict[K, V] {
 	return NewLockDict[K, V](cloned)
 }
 
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (m *LockDict[K, V]) CompareAndDelete(k K, old V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.CompareAndDelete(k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (m *LockDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.CompareAndSwap(k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (m *LockDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.Compute(k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (m *LockDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.ComputeIfAbsent(k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (m *LockDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.ComputeIfPresent(k, remapping)
+}
+
 // ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
 func (m *LockDict[K, V]) ContainsKey(k K) bool {
 	m.mu.RLock()
@@ -104,6 +152,16 @@ func (m *LockDict[K, V]) Keys() []K {
 	return m.data.Keys()
 }
 
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (m *LockDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.Merge(k, v, remapping)
+}
+
 // Put associate the specified value with the specified key in this dictionary.
 func (m *LockDict[K, V]) Put(k K, v V) V {
 	m.mu.Lock()
@@ -112,6 +170,16 @@ func (m *LockDict[K, V]) Put(k K, v V) V {
 	return m.data.Put(k, v)
 }
 
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (m *LockDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.data.PutIfAbsent(k, v)
+}
+
 // Remove removes the key-value pair with the specified key.
 func (m *LockDict[K, V]) Remove(k K) V {
 	m.mu.Lock()
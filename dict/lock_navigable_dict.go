@@ -0,0 +1,128 @@
+package dict
+
+import (
+	"sync"
+
+	"github.com/ghosind/collection"
+)
+
+// LockNavigableDict is a thread-safe navigable dictionary that wraps another navigable dictionary
+// with read-write locks. The dictionaries returned by HeadDict, SubDict and TailDict wrap the views
+// of the underlying dictionary, and share the lock with this dictionary.
+type LockNavigableDict[K comparable, V any] struct {
+	LockSortedDict[K, V]
+	navigable collection.NavigableDict[K, V]
+}
+
+// NewLockNavigableDict creates a new LockNavigableDict.
+func NewLockNavigableDict[K comparable, V any](
+	data collection.NavigableDict[K, V],
+) *LockNavigableDict[K, V] {
+	d := new(LockNavigableDict[K, V])
+	d.data = data
+	d.sorted = data
+	d.navigable = data
+	d.mu = new(sync.RWMutex)
+
+	return d
+}
+
+// Ceiling returns the least key greater than or equal to the specified key, or false if there is
+// no such key.
+func (m *LockNavigableDict[K, V]) Ceiling(k K) (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.navigable.Ceiling(k)
+}
+
+// Clone returns a copy of this dictionary.
+func (m *LockNavigableDict[K, V]) Clone() collection.Dict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cloned := m.navigable.Clone().(collection.NavigableDict[K, V])
+
+	return NewLockNavigableDict[K, V](cloned)
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (m *LockNavigableDict[K, V]) Equals(o any) bool {
+	om, ok := o.(*LockNavigableDict[K, V])
+	if !ok {
+		return false
+	}
+
+	return m.LockSortedDict.Equals(&om.LockSortedDict)
+}
+
+// Floor returns the greatest key less than or equal to the specified key, or false if there is no
+// such key.
+func (m *LockNavigableDict[K, V]) Floor(k K) (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.navigable.Floor(k)
+}
+
+// HeadDict returns a view of the key-value pairs whose keys are strictly less than toKey, the view
+// shares the lock with this dictionary.
+func (m *LockNavigableDict[K, V]) HeadDict(toKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.navigable.HeadDict(toKey))
+}
+
+// Higher returns the least key strictly greater than the specified key, or false if there is no
+// such key.
+func (m *LockNavigableDict[K, V]) Higher(k K) (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.navigable.Higher(k)
+}
+
+// Lower returns the greatest key strictly less than the specified key, or false if there is no
+// such key.
+func (m *LockNavigableDict[K, V]) Lower(k K) (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.navigable.Lower(k)
+}
+
+// SubDict returns a view of the key-value pairs whose keys range from fromKey, inclusive, to toKey,
+// exclusive, the view shares the lock with this dictionary.
+func (m *LockNavigableDict[K, V]) SubDict(fromKey, toKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.navigable.SubDict(fromKey, toKey))
+}
+
+// TailDict returns a view of the key-value pairs whose keys are greater than or equal to fromKey,
+// the view shares the lock with this dictionary.
+func (m *LockNavigableDict[K, V]) TailDict(fromKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.navigable.TailDict(fromKey))
+}
+
+// view returns a dictionary that wraps the specified view of the underlying dictionary, and shares
+// the lock with this dictionary. The result is a LockNavigableDict if the view is navigable.
+func (m *LockNavigableDict[K, V]) view(data collection.SortedDict[K, V]) collection.SortedDict[K, V] {
+	navigable, ok := data.(collection.NavigableDict[K, V])
+	if !ok {
+		return m.LockSortedDict.view(data)
+	}
+
+	d := new(LockNavigableDict[K, V])
+	d.data = navigable
+	d.sorted = navigable
+	d.navigable = navigable
+	d.mu = m.mu
+
+	return d
+}
@@ -0,0 +1,144 @@
+package dict
+
+import (
+	"sync"
+
+	"github.com/ghosind/collection"
+)
+
+// LockSortedDict is a thread-safe sorted dictionary that wraps another sorted dictionary with
+// read-write locks. The dictionaries returned by HeadDict, SubDict and TailDict wrap the views of
+// the underlying dictionary, and share the lock with this dictionary. Use LockNavigableDict to keep
+// the closest-key searching methods of a NavigableDict like TreeDict.
+type LockSortedDict[K comparable, V any] struct {
+	LockDict[K, V]
+	sorted collection.SortedDict[K, V]
+}
+
+// NewLockSortedDict creates a new LockSortedDict.
+func NewLockSortedDict[K comparable, V any](data collection.SortedDict[K, V]) *LockSortedDict[K, V] {
+	d := new(LockSortedDict[K, V])
+	d.data = data
+	d.sorted = data
+	d.mu = new(sync.RWMutex)
+
+	return d
+}
+
+// Clone returns a copy of this dictionary.
+func (m *LockSortedDict[K, V]) Clone() collection.Dict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	cloned := m.sorted.Clone().(collection.SortedDict[K, V])
+
+	return NewLockSortedDict[K, V](cloned)
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (m *LockSortedDict[K, V]) Equals(o any) bool {
+	om, ok := o.(*LockSortedDict[K, V])
+	if !ok {
+		return false
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if om.mu != m.mu {
+		om.mu.RLock()
+		defer om.mu.RUnlock()
+	}
+
+	return m.sorted.Equals(om.sorted)
+}
+
+// FirstEntry returns the key-value pair with the lowest key in this dictionary, or false if this
+// dictionary is empty.
+func (m *LockSortedDict[K, V]) FirstEntry() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sorted.FirstEntry()
+}
+
+// FirstKey returns the lowest key in this dictionary, or false if this dictionary is empty.
+func (m *LockSortedDict[K, V]) FirstKey() (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sorted.FirstKey()
+}
+
+// HeadDict returns a view of the key-value pairs whose keys are strictly less than toKey, the view
+// shares the lock with this dictionary.
+func (m *LockSortedDict[K, V]) HeadDict(toKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.sorted.HeadDict(toKey))
+}
+
+// LastEntry returns the key-value pair with the highest key in this dictionary, or false if this
+// dictionary is empty.
+func (m *LockSortedDict[K, V]) LastEntry() (K, V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sorted.LastEntry()
+}
+
+// LastKey returns the highest key in this dictionary, or false if this dictionary is empty.
+func (m *LockSortedDict[K, V]) LastKey() (K, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sorted.LastKey()
+}
+
+// PollFirst removes and returns the key-value pair with the lowest key in this dictionary, or false
+// if this dictionary is empty.
+func (m *LockSortedDict[K, V]) PollFirst() (K, V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sorted.PollFirst()
+}
+
+// PollLast removes and returns the key-value pair with the highest key in this dictionary, or false
+// if this dictionary is empty.
+func (m *LockSortedDict[K, V]) PollLast() (K, V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sorted.PollLast()
+}
+
+// SubDict returns a view of the key-value pairs whose keys range from fromKey, inclusive, to toKey,
+// exclusive, the view shares the lock with this dictionary.
+func (m *LockSortedDict[K, V]) SubDict(fromKey, toKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.sorted.SubDict(fromKey, toKey))
+}
+
+// TailDict returns a view of the key-value pairs whose keys are greater than or equal to fromKey,
+// the view shares the lock with this dictionary.
+func (m *LockSortedDict[K, V]) TailDict(fromKey K) collection.SortedDict[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.view(m.sorted.TailDict(fromKey))
+}
+
+// view returns a LockSortedDict that wraps the specified view of the underlying dictionary, and
+// shares the lock with this dictionary.
+func (m *LockSortedDict[K, V]) view(data collection.SortedDict[K, V]) *LockSortedDict[K, V] {
+	d := new(LockSortedDict[K, V])
+	d.data = data
+	d.sorted = data
+	d.mu = m.mu
+
+	return d
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// DescendingIter returns an iterator of all key-value pairs in this dictionary in descending key
+// order.
+func (m *LockSortedDict[K, V]) DescendingIter() iter.Seq2[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.sorted.DescendingIter()
+}
@@ -0,0 +1,234 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// multiDict is the common implementation of the MultiDict, it stores the values of each key in a
+// collection created by the newValues function.
+type multiDict[K comparable, V any] struct {
+	entries   map[K]collection.Collection[V]
+	size      int
+	newValues func() collection.Collection[V]
+}
+
+// init initializes the dictionary with the function to create the value collections.
+func (d *multiDict[K, V]) init(newValues func() collection.Collection[V]) {
+	d.entries = make(map[K]collection.Collection[V])
+	d.newValues = newValues
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *multiDict[K, V]) Clear() {
+	d.entries = make(map[K]collection.Collection[V])
+	d.size = 0
+}
+
+// ContainsEntry returns true if this dictionary contains the specified key-value pair.
+func (d *multiDict[K, V]) ContainsEntry(k K, v V) bool {
+	values, ok := d.entries[k]
+	if !ok {
+		return false
+	}
+
+	return values.Contains(v)
+}
+
+// ContainsKey returns true if this dictionary contains at least one value with the specified key.
+func (d *multiDict[K, V]) ContainsKey(k K) bool {
+	_, ok := d.entries[k]
+
+	return ok
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+// have been processed or the handler returns an error.
+func (d *multiDict[K, V]) ForEach(handler func(k K, v V) error) error {
+	for k, values := range d.entries {
+		if err := values.ForEach(func(v V) error {
+			return handler(k, v)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAll returns a slice of the values associated with the specified key.
+func (d *multiDict[K, V]) GetAll(k K) []V {
+	values, ok := d.entries[k]
+	if !ok {
+		return []V{}
+	}
+
+	return values.ToSlice()
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *multiDict[K, V]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Keys returns a slice that contains all the distinct keys in this dictionary.
+func (d *multiDict[K, V]) Keys() []K {
+	keys := make([]K, 0, len(d.entries))
+	for k := range d.entries {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Put associates the specified value with the specified key in addition to the existing values,
+// and returns true if this dictionary changed.
+func (d *multiDict[K, V]) Put(k K, v V) bool {
+	return d.PutAll(k, v)
+}
+
+// PutAll associates all of the specified values with the specified key in addition to the existing
+// values, and returns true if this dictionary changed.
+func (d *multiDict[K, V]) PutAll(k K, values ...V) bool {
+	if len(values) == 0 {
+		return false
+	}
+
+	c, ok := d.entries[k]
+	if !ok {
+		c = d.newValues()
+		d.entries[k] = c
+	}
+
+	old := c.Size()
+	c.AddAll(values...)
+	d.size += c.Size() - old
+
+	return c.Size() != old
+}
+
+// RemoveAll removes all values associated with the specified key, and returns the removed values.
+func (d *multiDict[K, V]) RemoveAll(k K) []V {
+	values, ok := d.entries[k]
+	if !ok {
+		return []V{}
+	}
+
+	delete(d.entries, k)
+	d.size -= values.Size()
+
+	return values.ToSlice()
+}
+
+// RemoveValue removes the specified value associated with the specified key, and returns true if
+// this dictionary changed.
+func (d *multiDict[K, V]) RemoveValue(k K, v V) bool {
+	values, ok := d.entries[k]
+	if !ok {
+		return false
+	}
+
+	old := values.Size()
+	if !values.Remove(v) {
+		return false
+	}
+	d.size -= old - values.Size()
+
+	if values.IsEmpty() {
+		delete(d.entries, k)
+	}
+
+	return true
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *multiDict[K, V]) Size() int {
+	return d.size
+}
+
+// String returns the string representation of this dictionary.
+func (d *multiDict[K, V]) String() string {
+	buf := bytes.NewBufferString("multidict[")
+	first := true
+	for k, values := range d.entries {
+		if !first {
+			buf.WriteString(" ")
+		}
+		first = false
+		buf.WriteString(internal.ValueString(k))
+		buf.WriteString(": [")
+		for i, v := range values.ToSlice() {
+			if i > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(internal.ValueString(v))
+		}
+		buf.WriteString("]")
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains the values of all key-value pairs in this dictionary.
+func (d *multiDict[K, V]) Values() []V {
+	values := make([]V, 0, d.size)
+	for _, c := range d.entries {
+		values = append(values, c.ToSlice()...)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the dictionary as a JSON object, the values of each key are marshaled as a
+// JSON array.
+func (d *multiDict[K, V]) MarshalJSON() ([]byte, error) {
+	m := make(map[K][]V, len(d.entries))
+	for k, values := range d.entries {
+		m[k] = values.ToSlice()
+	}
+
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON unmarshals a JSON object that the value of each member is a JSON array into the
+// dictionary.
+func (d *multiDict[K, V]) UnmarshalJSON(b []byte) error {
+	var m map[K][]V
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+
+	d.Clear()
+	for k, values := range m {
+		d.PutAll(k, values...)
+	}
+
+	return nil
+}
+
+// cloneTo copies all key-value pairs of this dictionary into the empty dictionary.
+func (d *multiDict[K, V]) cloneTo(o *multiDict[K, V]) {
+	for k, values := range d.entries {
+		o.PutAll(k, values.ToSlice()...)
+	}
+}
+
+// equals returns true if both dictionaries contain the same keys and the value collections of each
+// key are equal.
+func (d *multiDict[K, V]) equals(o *multiDict[K, V]) bool {
+	if d.size != o.size || len(d.entries) != len(o.entries) {
+		return false
+	}
+
+	for k, values := range d.entries {
+		ovalues, ok := o.entries[k]
+		if !ok || !values.Equals(ovalues) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of all key-value pairs in this dictionary.
+func (d *multiDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, values := range d.entries {
+			for v := range values.Iter() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package dict
+
+import (
+	"github.com/ghosind/go-assert"
+)
+
+func testMultiDictIter(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+	d.PutAll("a", 1, 2)
+	d.Put("b", 3)
+
+	sum := 0
+	for k, v := range d.Iter() {
+		a.TrueNow(d.ContainsEntry(k, v))
+		sum += v
+	}
+	a.EqualNow(6, sum)
+
+	for range d.Iter() {
+		// yield should returns false
+		break
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !go1.23
+
+package dict
+
+import (
+	"reflect"
+
+	"github.com/ghosind/go-assert"
+)
+
+func testMultiDictIter(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+
+	ty := reflect.TypeOf(d)
+	_, ok := ty.MethodByName("Iter")
+	a.NotTrueNow(ok)
+}
@@ -0,0 +1,133 @@
+package dict
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type multiDictConstructor func() collection.MultiDict[string, int]
+
+func testMultiDict(a *assert.Assertion, constructor multiDictConstructor) {
+	testMultiDictPut(a, constructor)
+	testMultiDictRemove(a, constructor)
+	testMultiDictClone(a, constructor)
+	testMultiDictForEach(a, constructor)
+	testMultiDictIter(a, constructor)
+	testMultiDictJSON(a, constructor)
+}
+
+func testMultiDictPut(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+
+	a.TrueNow(d.IsEmpty())
+	a.EqualNow([]int{}, d.GetAll("a"))
+	a.NotTrueNow(d.ContainsKey("a"))
+
+	a.TrueNow(d.Put("a", 1))
+	a.TrueNow(d.Put("a", 2))
+	a.TrueNow(d.PutAll("b", 3, 4))
+	a.NotTrueNow(d.PutAll("c"))
+	a.NotTrueNow(d.ContainsKey("c"))
+
+	a.EqualNow(4, d.Size())
+	a.EqualNow([]int{1, 2}, sortedInts(d.GetAll("a")))
+	a.EqualNow([]int{3, 4}, sortedInts(d.GetAll("b")))
+	a.TrueNow(d.ContainsKey("a"))
+	a.TrueNow(d.ContainsEntry("a", 2))
+	a.NotTrueNow(d.ContainsEntry("a", 3))
+	a.NotTrueNow(d.ContainsEntry("c", 1))
+
+	keys := d.Keys()
+	sort.Strings(keys)
+	a.EqualNow([]string{"a", "b"}, keys)
+	a.EqualNow([]int{1, 2, 3, 4}, sortedInts(d.Values()))
+
+	d.Clear()
+	a.TrueNow(d.IsEmpty())
+	a.EqualNow(0, d.Size())
+	a.EqualNow("multidict[]", d.String())
+}
+
+func testMultiDictRemove(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+	d.PutAll("a", 1, 2)
+	d.PutAll("b", 3, 4)
+
+	a.TrueNow(d.RemoveValue("a", 1))
+	a.NotTrueNow(d.RemoveValue("a", 1))
+	a.NotTrueNow(d.RemoveValue("c", 1))
+	a.EqualNow(3, d.Size())
+	a.TrueNow(d.RemoveValue("a", 2))
+	a.NotTrueNow(d.ContainsKey("a"))
+	a.EqualNow(2, d.Size())
+
+	a.EqualNow([]int{3, 4}, sortedInts(d.RemoveAll("b")))
+	a.EqualNow([]int{}, d.RemoveAll("b"))
+	a.TrueNow(d.IsEmpty())
+}
+
+func testMultiDictClone(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+	d.PutAll("a", 1, 2)
+	d.Put("b", 3)
+
+	clone := d.Clone()
+	a.TrueNow(d.Equals(clone))
+	a.EqualNow(d.Size(), clone.Size())
+
+	clone.Put("b", 4)
+	a.NotTrueNow(d.Equals(clone))
+	a.EqualNow([]int{3}, d.GetAll("b"))
+
+	other := constructor()
+	other.PutAll("a", 1, 2)
+	other.Put("c", 3)
+	a.NotTrueNow(d.Equals(other))
+	a.NotTrueNow(d.Equals(NewHashDict[string, int]()))
+}
+
+func testMultiDictForEach(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+	d.PutAll("a", 1, 2)
+	d.Put("b", 3)
+
+	sum := 0
+	a.NilNow(d.ForEach(func(k string, v int) error {
+		a.TrueNow(d.ContainsEntry(k, v))
+		sum += v
+		return nil
+	}))
+	a.EqualNow(6, sum)
+
+	expectedErr := errors.New("expected error")
+	a.EqualNow(expectedErr, d.ForEach(func(k string, v int) error {
+		return expectedErr
+	}))
+}
+
+func testMultiDictJSON(a *assert.Assertion, constructor multiDictConstructor) {
+	d := constructor()
+	d.Put("a", 1)
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"a":[1]}`, string(b))
+
+	d2 := constructor()
+	d2.Put("c", 1)
+	a.NilNow(json.Unmarshal([]byte(`{"a":[1,2],"b":[3]}`), d2))
+	a.EqualNow(3, d2.Size())
+	a.EqualNow([]int{1, 2}, sortedInts(d2.GetAll("a")))
+	a.NotTrueNow(d2.ContainsKey("c"))
+
+	a.NotNilNow(json.Unmarshal([]byte(`{"a":1}`), d2))
+}
+
+func sortedInts(s []int) []int {
+	sort.Ints(s)
+	return s
+}
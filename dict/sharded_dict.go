@@ -0,0 +1,401 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// DefaultShardCount is the number of shards of the ShardedDict created with a non-positive shard
+// count.
+const DefaultShardCount = 32
+
+// dictShard is a shard of the ShardedDict, it is a HashDict guarded by a read-write lock.
+type dictShard[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data HashDict[K, V]
+}
+
+// ShardedDict is a thread-safe dictionary that partitions the keys across several HashDicts by the
+// hash of the keys, and each HashDict is guarded by its own read-write lock. The operations on the
+// keys in different shards do not block each other, so it performs better than LockDict and
+// SyncDict for the write-heavy workloads.
+//
+// The methods that work across the shards, like Size, ForEach and Clone, lock the shards one by
+// one, so they do not see a consistent snapshot of the dictionary if it is modified concurrently.
+// The dictionary must be created by NewShardedDict or NewShardedDictWithHash.
+type ShardedDict[K comparable, V any] struct {
+	shards []*dictShard[K, V]
+	hash   func(K) uint64
+}
+
+// NewShardedDict creates a new ShardedDict with the specified number of shards and the default hash
+// function, it uses DefaultShardCount shards if the count is not positive.
+func NewShardedDict[K comparable, V any](shards int) *ShardedDict[K, V] {
+	return NewShardedDictWithHash[K, V](shards, nil)
+}
+
+// NewShardedDictWithHash creates a new ShardedDict with the specified number of shards and hash
+// function, the equal keys must have the same hash. It uses DefaultShardCount shards if the count is
+// not positive, and uses the default hash function if the hash function is nil.
+func NewShardedDictWithHash[K comparable, V any](shards int, hash func(K) uint64) *ShardedDict[K, V] {
+	d := new(ShardedDict[K, V])
+	d.init(shards, hash)
+
+	return d
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *ShardedDict[K, V]) Clear() {
+	for _, s := range d.shards {
+		s.mu.Lock()
+		s.data.Clear()
+		s.mu.Unlock()
+	}
+}
+
+// Clone returns a copy of this dictionary with the same number of shards and hash function.
+func (d *ShardedDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := NewShardedDictWithHash[K, V](len(d.shards), d.hash)
+
+	for i, s := range d.shards {
+		s.mu.RLock()
+		for k, v := range s.data {
+			newDict.shards[i].data[k] = v
+		}
+		s.mu.RUnlock()
+	}
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *ShardedDict[K, V]) CompareAndDelete(k K, old V) bool {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.CompareAndDelete(k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *ShardedDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.CompareAndSwap(k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *ShardedDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.Compute(k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *ShardedDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.ComputeIfAbsent(k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *ShardedDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.ComputeIfPresent(k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
+func (d *ShardedDict[K, V]) ContainsKey(k K) bool {
+	s := d.shard(k)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.data[k]
+
+	return ok
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *ShardedDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*ShardedDict[K, V])
+	if !ok {
+		return false
+	}
+	if od == d {
+		return true
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for _, s := range d.shards {
+		keys, values := s.snapshot()
+		for i, k := range keys {
+			v, ok := od.Get(k)
+			if !ok || !internal.Equal(v, values[i]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+// have been processed or the handler returns an error. The handler runs on a snapshot of each
+// shard, so it can call the methods of this dictionary.
+func (d *ShardedDict[K, V]) ForEach(handler func(K, V) error) error {
+	for _, s := range d.shards {
+		keys, values := s.snapshot()
+		for i, k := range keys {
+			if err := handler(k, values[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key.
+func (d *ShardedDict[K, V]) Get(k K) (V, bool) {
+	s := d.shard(k)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	v, ok := s.data[k]
+
+	return v, ok
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *ShardedDict[K, V]) GetDefault(k K, defaultVal V) V {
+	v, ok := d.Get(k)
+	if !ok {
+		return defaultVal
+	}
+
+	return v
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *ShardedDict[K, V]) IsEmpty() bool {
+	for _, s := range d.shards {
+		s.mu.RLock()
+		size := len(s.data)
+		s.mu.RUnlock()
+
+		if size > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Keys returns a slice that contains all the keys in this dictionary.
+func (d *ShardedDict[K, V]) Keys() []K {
+	keys := make([]K, 0, d.Size())
+	for _, s := range d.shards {
+		s.mu.RLock()
+		for k := range s.data {
+			keys = append(keys, k)
+		}
+		s.mu.RUnlock()
+	}
+
+	return keys
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *ShardedDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.Merge(k, v, remapping)
+}
+
+// Put associate the specified value with the specified key in this dictionary.
+func (d *ShardedDict[K, V]) Put(k K, v V) V {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.Put(k, v)
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *ShardedDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.PutIfAbsent(k, v)
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *ShardedDict[K, V]) Remove(k K) V {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.Remove(k)
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary.
+func (d *ShardedDict[K, V]) Replace(k K, v V) (V, bool) {
+	s := d.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.data.Replace(k, v)
+}
+
+// Shards returns the number of shards of this dictionary.
+func (d *ShardedDict[K, V]) Shards() int {
+	return len(d.shards)
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *ShardedDict[K, V]) Size() int {
+	size := 0
+	for _, s := range d.shards {
+		s.mu.RLock()
+		size += len(s.data)
+		s.mu.RUnlock()
+	}
+
+	return size
+}
+
+// String returns the string representation of this dictionary.
+func (d *ShardedDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	count := 0
+	for _, s := range d.shards {
+		keys, values := s.snapshot()
+		for i, k := range keys {
+			if count > 0 {
+				buf.WriteString(" ")
+			}
+			buf.WriteString(internal.ValueString(k))
+			buf.WriteString(": ")
+			buf.WriteString(internal.ValueString(values[i]))
+			count++
+		}
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// Values returns a slice that contains all the values in this dictionary.
+func (d *ShardedDict[K, V]) Values() []V {
+	values := make([]V, 0, d.Size())
+	for _, s := range d.shards {
+		s.mu.RLock()
+		for _, v := range s.data {
+			values = append(values, v)
+		}
+		s.mu.RUnlock()
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the ShardedDict as a JSON object (map).
+func (d *ShardedDict[K, V]) MarshalJSON() ([]byte, error) {
+	m := make(map[K]V)
+	for _, s := range d.shards {
+		s.mu.RLock()
+		for k, v := range s.data {
+			m[k] = v
+		}
+		s.mu.RUnlock()
+	}
+
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON unmarshals a JSON object into the ShardedDict. A zero value ShardedDict uses
+// DefaultShardCount shards and the default hash function.
+func (d *ShardedDict[K, V]) UnmarshalJSON(b []byte) error {
+	var tmp map[K]V
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+
+	if d.shards == nil {
+		d.init(DefaultShardCount, nil)
+	}
+
+	d.Clear()
+	for k, v := range tmp {
+		d.Put(k, v)
+	}
+
+	return nil
+}
+
+// init creates the shards and sets the hash function.
+func (d *ShardedDict[K, V]) init(shards int, hash func(K) uint64) {
+	if shards <= 0 {
+		shards = DefaultShardCount
+	}
+	if hash == nil {
+		hash = newDefaultHash[K]()
+	}
+
+	d.shards = make([]*dictShard[K, V], shards)
+	for i := range d.shards {
+		d.shards[i] = &dictShard[K, V]{data: make(HashDict[K, V])}
+	}
+	d.hash = hash
+}
+
+// shard returns the shard of the specified key.
+func (d *ShardedDict[K, V]) shard(k K) *dictShard[K, V] {
+	return d.shards[d.hash(k)%uint64(len(d.shards))]
+}
+
+// snapshot returns the keys and values of all key-value pairs in the shard, the values are in the
+// same order of the keys.
+func (s *dictShard[K, V]) snapshot() ([]K, []V) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]K, 0, len(s.data))
+	values := make([]V, 0, len(s.data))
+	for k, v := range s.data {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	return keys, values
+}
@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of all key-value pairs in this dictionary, it iterates on a snapshot of
+// each shard.
+func (d *ShardedDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, s := range d.shards {
+			keys, values := s.snapshot()
+			for i, k := range keys {
+				if !yield(k, values[i]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// KeysIter returns an iterator of all keys in this dictionary, it iterates on a snapshot of each
+// shard.
+func (d *ShardedDict[K, V]) KeysIter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, s := range d.shards {
+			keys, _ := s.snapshot()
+			for _, k := range keys {
+				if !yield(k) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ValuesIter returns an iterator of all values in this dictionary, it iterates on a snapshot of
+// each shard.
+func (d *ShardedDict[K, V]) ValuesIter() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, s := range d.shards {
+			_, values := s.snapshot()
+			for _, v := range values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+//go:build go1.24
+
+package dict
+
+import "hash/maphash"
+
+// newDefaultHash returns the default hash function of the ShardedDict, it uses maphash.Comparable
+// with a random seed.
+func newDefaultHash[K comparable]() func(K) uint64 {
+	seed := maphash.MakeSeed()
+
+	return func(k K) uint64 {
+		return maphash.Comparable(seed, k)
+	}
+}
@@ -0,0 +1,96 @@
+package dict
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// newReflectHash returns a hash function that hashes the keys by their values in the same way as
+// the == operator compares them. The pointers and channels are hashed by their addresses, the
+// floating-point 0 and -0 have the same hash, and the arrays, structs and interfaces are hashed by
+// their elements, fields and dynamic values.
+func newReflectHash[K comparable]() func(K) uint64 {
+	seed := maphash.MakeSeed()
+
+	return func(k K) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+
+		switch v := any(k).(type) {
+		case string:
+			h.WriteString(v)
+		case int:
+			writeUint64(&h, uint64(v))
+		default:
+			hashValue(&h, reflect.ValueOf(k))
+		}
+
+		return h.Sum64()
+	}
+}
+
+// hashValue writes the value into the hash, the equal values write the same bytes.
+func hashValue(h *maphash.Hash, v reflect.Value) {
+	if !v.IsValid() {
+		// nil interface
+		h.WriteByte(0)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			h.WriteByte(1)
+		} else {
+			h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat(h, real(c))
+		writeFloat(h, imag(c))
+	case reflect.String:
+		h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			hashValue(h, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			hashValue(h, v.Field(i))
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			h.WriteByte(0)
+			return
+		}
+		elem := v.Elem()
+		h.WriteByte(1)
+		h.WriteString(elem.Type().String())
+		hashValue(h, elem)
+	}
+}
+
+// writeFloat writes the floating-point number into the hash, 0 and -0 write the same bytes.
+func writeFloat(h *maphash.Hash, f float64) {
+	if f == 0 {
+		f = 0
+	}
+	writeUint64(h, math.Float64bits(f))
+}
+
+// writeUint64 writes the number into the hash in the little-endian order.
+func writeUint64(h *maphash.Hash, n uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+	h.Write(buf[:])
+}
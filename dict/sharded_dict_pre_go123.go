@@ -0,0 +1,33 @@
+//go:build !go1.23
+
+package dict
+
+// KeysIter returns a channel iterator of all keys in this dictionary.
+func (d *ShardedDict[K, V]) KeysIter() <-chan K {
+	ch := make(chan K)
+	go func() {
+		for _, s := range d.shards {
+			keys, _ := s.snapshot()
+			for _, k := range keys {
+				ch <- k
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary.
+func (d *ShardedDict[K, V]) ValuesIter() <-chan V {
+	ch := make(chan V)
+	go func() {
+		for _, s := range d.shards {
+			_, values := s.snapshot()
+			for _, v := range values {
+				ch <- v
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
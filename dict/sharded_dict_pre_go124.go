@@ -0,0 +1,9 @@
+//go:build !go1.24
+
+package dict
+
+// newDefaultHash returns the default hash function of the ShardedDict, it hashes the keys by
+// reflection in the same way as the == operator compares them, see newReflectHash.
+func newDefaultHash[K comparable]() func(K) uint64 {
+	return newReflectHash[K]()
+}
@@ -0,0 +1,192 @@
+package dict
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func shardedDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	d := NewShardedDict[string, string](8)
+	if len(initData) > 0 {
+		for k, v := range initData[0] {
+			d.Put(k, v)
+		}
+	}
+	return d
+}
+
+func TestShardedDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, shardedDictConstructor)
+}
+
+func TestShardedDictShards(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(DefaultShardCount, NewShardedDict[int, int](0).Shards())
+	a.EqualNow(4, NewShardedDict[int, int](4).Shards())
+
+	d := NewShardedDictWithHash[int, int](4, func(k int) uint64 {
+		return uint64(k)
+	})
+	for i := 0; i < 16; i++ {
+		d.Put(i, i)
+	}
+	for _, s := range d.shards {
+		a.EqualNow(4, len(s.data))
+	}
+	a.EqualNow(16, d.Size())
+
+	cloned := d.Clone().(*ShardedDict[int, int])
+	a.EqualNow(4, cloned.Shards())
+	a.TrueNow(d.Equals(cloned))
+	for i, s := range cloned.shards {
+		a.EqualNow(len(d.shards[i].data), len(s.data))
+	}
+
+	cloned.Put(1, 10)
+	a.NotTrueNow(d.Equals(cloned))
+	a.TrueNow(d.Equals(d))
+}
+
+func TestShardedDictFloatKeys(t *testing.T) {
+	a := assert.New(t)
+	d := NewShardedDict[float64, int](8)
+
+	for i := 0; i < 100; i++ {
+		d.Put(float64(i)/10, i)
+	}
+	for i := 0; i < 100; i++ {
+		a.EqualNow(i, d.GetDefault(float64(i)/10, -1))
+	}
+}
+
+func TestShardedDictReflectHash(t *testing.T) {
+	a := assert.New(t)
+
+	type node struct {
+		id int
+	}
+	type key struct {
+		name string
+		node *node
+		pair [2]float64
+	}
+
+	nodes := NewShardedDictWithHash[*node, int](16, newReflectHash[*node]())
+	n1, n2 := &node{1}, &node{1}
+	nodes.Put(n1, 1)
+	nodes.Put(n2, 2)
+	n1.id = 2
+	a.EqualNow(2, nodes.Size())
+	a.EqualNow(1, nodes.GetDefault(n1, 0))
+	a.EqualNow(2, nodes.GetDefault(n2, 0))
+	a.TrueNow(nodes.ContainsKey(n1))
+	a.EqualNow(1, nodes.Remove(n1))
+	a.NotTrueNow(nodes.ContainsKey(n1))
+
+	hash := newReflectHash[float64]()
+	a.EqualNow(hash(0), hash(math.Copysign(0, -1)))
+	floats := NewShardedDictWithHash[float64, int](16, hash)
+	floats.Put(0, 1)
+	a.EqualNow(1, floats.GetDefault(math.Copysign(0, -1), 0))
+
+	keyHash := newReflectHash[key]()
+	k1 := key{"a", n1, [2]float64{0, 1}}
+	k2 := key{"a", n1, [2]float64{math.Copysign(0, -1), 1}}
+	a.EqualNow(keyHash(k1), keyHash(k2))
+	keys := NewShardedDictWithHash[key, int](16, keyHash)
+	for i := 0; i < 100; i++ {
+		keys.Put(key{"k", n2, [2]float64{float64(i), 0}}, i)
+	}
+	keys.Put(key{}, -1)
+	for i := 0; i < 100; i++ {
+		a.EqualNow(i, keys.GetDefault(key{"k", n2, [2]float64{float64(i), 0}}, 0))
+	}
+	a.EqualNow(-1, keys.GetDefault(key{}, 0))
+
+	seed := maphash.MakeSeed()
+	ifaceHash := func(v any) uint64 {
+		var h maphash.Hash
+		h.SetSeed(seed)
+		hashValue(&h, reflect.ValueOf(struct{ v any }{v}))
+		return h.Sum64()
+	}
+	a.EqualNow(ifaceHash(nil), ifaceHash(nil))
+	a.EqualNow(ifaceHash(n1), ifaceHash(n1))
+	a.NotEqualNow(ifaceHash(n1), ifaceHash(n2))
+	a.EqualNow(ifaceHash(k1), ifaceHash(k2))
+	a.NotEqualNow(ifaceHash(1), ifaceHash("1"))
+}
+
+func TestShardedDictForEachModify(t *testing.T) {
+	a := assert.New(t)
+	d := NewShardedDict[int, int](4)
+	for i := 0; i < 10; i++ {
+		d.Put(i, i)
+	}
+
+	a.NilNow(d.ForEach(func(k, v int) error {
+		d.Put(k, v*2)
+		return nil
+	}))
+	for i := 0; i < 10; i++ {
+		a.EqualNow(i*2, d.GetDefault(i, 0))
+	}
+}
+
+func TestShardedDictConcurrent(t *testing.T) {
+	a := assert.New(t)
+	d := NewShardedDict[int, int](16)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				d.Put(n*1000+j, j)
+				d.Get(j)
+				if j%2 == 0 {
+					d.Remove(n*1000 + j)
+				}
+			}
+			d.Size()
+			d.Keys()
+		}(i)
+	}
+	wg.Wait()
+
+	a.EqualNow(4000, d.Size())
+	a.EqualNow(4000, len(d.Values()))
+}
+
+func TestShardedDictJSON(t *testing.T) {
+	a := assert.New(t)
+
+	var d ShardedDict[string, int]
+	a.NilNow(json.Unmarshal([]byte(`{"a":1,"b":2}`), &d))
+	a.EqualNow(DefaultShardCount, d.Shards())
+	a.EqualNow(2, d.Size())
+	a.EqualNow(2, d.GetDefault("b", 0))
+
+	b, err := json.Marshal(&d)
+	a.NilNow(err)
+	a.EqualNow(`{"a":1,"b":2}`, string(b))
+}
+
+func BenchmarkShardedDict_Get(b *testing.B) {
+	benchmarkDict_Get(b, shardedDictConstructor, true)
+}
+
+func BenchmarkShardedDict_Put(b *testing.B) {
+	benchmarkDict_Put(b, shardedDictConstructor, true)
+}
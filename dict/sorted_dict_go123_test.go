@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package dict
+
+import "github.com/ghosind/go-assert"
+
+func testSortedDictDescendingIter(a *assert.Assertion, constructor sortedDictConstructor) {
+	d := constructor(testSortedKeys...)
+
+	keys := make([]int, 0, d.Size())
+	for k, v := range d.DescendingIter() {
+		a.EqualNow(k*10, v)
+		keys = append(keys, k)
+	}
+	a.EqualNow([]int{50, 40, 30, 20, 10}, keys)
+
+	for range d.DescendingIter() {
+		// yield should returns false
+		break
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !go1.23
+
+package dict
+
+import (
+	"reflect"
+
+	"github.com/ghosind/go-assert"
+)
+
+func testSortedDictDescendingIter(a *assert.Assertion, constructor sortedDictConstructor) {
+	d := constructor()
+
+	ty := reflect.TypeOf(d)
+	_, ok := ty.MethodByName("DescendingIter")
+	a.NotTrueNow(ok)
+}
@@ -0,0 +1,114 @@
+package dict
+
+import (
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type sortedDictConstructor func(...int) collection.SortedDict[int, int]
+
+var testSortedKeys = []int{30, 10, 50, 20, 40}
+
+func testSortedDict(a *assert.Assertion, constructor sortedDictConstructor) {
+	testSortedDictFirstLast(a, constructor)
+	testSortedDictPoll(a, constructor)
+	testSortedDictRange(a, constructor)
+	testSortedDictDescendingIter(a, constructor)
+}
+
+func testSortedDictFirstLast(a *assert.Assertion, constructor sortedDictConstructor) {
+	d := constructor()
+
+	_, ok := d.FirstKey()
+	a.NotTrueNow(ok)
+	_, _, ok = d.FirstEntry()
+	a.NotTrueNow(ok)
+	_, ok = d.LastKey()
+	a.NotTrueNow(ok)
+	_, _, ok = d.LastEntry()
+	a.NotTrueNow(ok)
+
+	d = constructor(testSortedKeys...)
+
+	k, ok := d.FirstKey()
+	a.TrueNow(ok)
+	a.EqualNow(10, k)
+	k, v, ok := d.FirstEntry()
+	a.TrueNow(ok)
+	a.EqualNow(10, k)
+	a.EqualNow(100, v)
+
+	k, ok = d.LastKey()
+	a.TrueNow(ok)
+	a.EqualNow(50, k)
+	k, v, ok = d.LastEntry()
+	a.TrueNow(ok)
+	a.EqualNow(50, k)
+	a.EqualNow(500, v)
+	a.EqualNow(len(testSortedKeys), d.Size())
+}
+
+func testSortedDictPoll(a *assert.Assertion, constructor sortedDictConstructor) {
+	d := constructor(testSortedKeys...)
+
+	k, v, ok := d.PollFirst()
+	a.TrueNow(ok)
+	a.EqualNow(10, k)
+	a.EqualNow(100, v)
+
+	k, v, ok = d.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(50, k)
+	a.EqualNow(500, v)
+
+	a.EqualNow([]int{20, 30, 40}, d.Keys())
+
+	d.Clear()
+	_, _, ok = d.PollFirst()
+	a.NotTrueNow(ok)
+	_, _, ok = d.PollLast()
+	a.NotTrueNow(ok)
+}
+
+func testSortedDictRange(a *assert.Assertion, constructor sortedDictConstructor) {
+	d := constructor(testSortedKeys...)
+
+	a.EqualNow([]int{10, 20}, d.HeadDict(30).Keys())
+	a.EqualNow([]int{}, d.HeadDict(10).Keys())
+	a.EqualNow([]int{30, 40, 50}, d.TailDict(30).Keys())
+	a.EqualNow([]int{30, 40, 50}, d.TailDict(25).Keys())
+	a.EqualNow([]int{20, 30}, d.SubDict(20, 40).Keys())
+	a.EqualNow([]int{20, 30, 40}, d.SubDict(15, 45).Keys())
+
+	sub := d.SubDict(20, 40)
+	sub.Put(25, 250)
+	a.EqualNow(250, sub.GetDefault(25, 0))
+	a.EqualNow(250, d.GetDefault(25, 0))
+	d.Put(35, 350)
+	a.EqualNow([]int{20, 25, 30, 35}, sub.Keys())
+	a.EqualNow(4, sub.Size())
+	d.Remove(30)
+	a.EqualNow(200, sub.Remove(20))
+	a.EqualNow([]int{10, 25, 35, 40, 50}, d.Keys())
+
+	a.NotTrueNow(sub.ContainsKey(10))
+	a.EqualNow(0, sub.Remove(40))
+	a.TrueNow(d.ContainsKey(40))
+	a.PanicOfNow(func() {
+		sub.Put(40, 400)
+	}, collection.ErrKeyOutOfRange)
+	a.PanicOfNow(func() {
+		sub.Put(10, 100)
+	}, collection.ErrKeyOutOfRange)
+
+	a.EqualNow([]int{25}, sub.HeadDict(30).Keys())
+	a.EqualNow([]int{25, 35}, sub.SubDict(0, 100).Keys())
+	a.EqualNow([]int{35}, d.TailDict(30).HeadDict(40).Keys())
+
+	k, _, ok := sub.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(35, k)
+	sub.Clear()
+	a.TrueNow(sub.IsEmpty())
+	a.EqualNow([]int{10, 40, 50}, d.Keys())
+}
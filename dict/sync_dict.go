@@ -10,7 +10,9 @@ import (
 	"github.com/ghosind/collection/internal"
 )
 
-// SyncDict is a thread-safe map implementation based on sync.Map's algorithm.
+// SyncDict is a thread-safe map implementation based on sync.Map's algorithm. It also provides
+// LoadOrStore, LoadAndDelete, Swap, CompareAndSwap, CompareAndDelete and Range with the same
+// semantics as sync.Map, so the code using sync.Map can be migrated to it directly.
 type SyncDict[K comparable, V any] struct {
 	mu       sync.Mutex
 	read     atomic.Pointer[internal.SyncReadOnly[K, V]]
@@ -113,10 +115,12 @@ func (d *SyncDict[K, V]) get(key K, val V) (V, bool) {
 	return e.Load(val)
 }
 
-func (d *SyncDict[K, V]) swap(key K, val V, ignore bool) (*V, bool) {
+func (d *SyncDict[K, V]) swap(key K, val V) (*V, bool) {
 	read := d.loadReadOnly()
 	if e, ok := read.M[key]; ok {
-		return e.TrySwap(&val)
+		if v, ok := e.TrySwap(&val); ok {
+			return v, v != nil
+		}
 	}
 
 	d.mu.Lock()
@@ -134,7 +138,7 @@ func (d *SyncDict[K, V]) swap(key K, val V, ignore bool) (*V, bool) {
 		if v := e.SwapLocked(&val); v != nil {
 			return v, true
 		}
-	} else if !ignore {
+	} else {
 		if !read.Amended {
 			d.dirtyLocked()
 			d.read.Store(&internal.SyncReadOnly[K, V]{M: read.M, Amended: true})
@@ -144,6 +148,52 @@ func (d *SyncDict[K, V]) swap(key K, val V, ignore bool) (*V, bool) {
 	return nil, false
 }
 
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations. The remapping function may be called more than
+// once if the value is modified concurrently, and it must not modify this dictionary.
+func (d *SyncDict[K, V]) compute(key K, remapping func(V, bool) (V, bool)) (V, bool) {
+	read := d.loadReadOnly()
+	if e, ok := read.M[key]; ok {
+		if v, present, ok := e.TryCompute(remapping); ok {
+			return v, present
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.computeLocked(key, remapping)
+}
+
+// computeLocked is the same as compute but it must be called with the lock held, the remapping
+// function is retried only if the value is modified concurrently by the lock-free operations.
+func (d *SyncDict[K, V]) computeLocked(key K, remapping func(V, bool) (V, bool)) (V, bool) {
+	read := d.loadReadOnly()
+	if e, ok := read.M[key]; ok {
+		if e.UnexpungeLocked() {
+			d.dirty[key] = e
+		}
+		v, present, _ := e.TryCompute(remapping)
+		return v, present
+	} else if e, ok := d.dirty[key]; ok {
+		v, present, _ := e.TryCompute(remapping)
+		d.missLocked()
+		return v, present
+	}
+
+	v, keep := remapping(d.zero, false)
+	if !keep {
+		return d.zero, false
+	}
+	if !read.Amended {
+		d.dirtyLocked()
+		d.read.Store(&internal.SyncReadOnly[K, V]{M: read.M, Amended: true})
+	}
+	d.dirty[key] = internal.NewSyncEntry(v, d.expunged)
+
+	return v, true
+}
+
 // // Clear removes all key-value pairs in this dictionary.
 func (d *SyncDict[K, V]) Clear() {
 	d.mu.Lock()
@@ -178,6 +228,50 @@ func (d *SyncDict[K, V]) Clone() collection.Dict[K, V] {
 	return newDict
 }
 
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *SyncDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *SyncDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation. The remapping function may be called more than once if the value
+// is modified concurrently, so it should have no side effects.
+func (d *SyncDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key. The mapping function is called
+// at most once, and the concurrent calls for the same absent key are serialized so only one of them
+// calls the mapping function. It can be used to construct the value lazily.
+func (d *SyncDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	if v, ok := d.get(k, d.zero); ok {
+		return v
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return internal.ComputeIfAbsent(d.computeLocked, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation. The
+// remapping function may be called more than once if the value is modified concurrently, so it
+// should have no side effects.
+func (d *SyncDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
 // ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
 func (d *SyncDict[K, V]) ContainsKey(key K) bool {
 	_, ok := d.get(key, d.zero)
@@ -295,18 +389,9 @@ func (d *SyncDict[K, V]) Keys() []K {
 	return keys
 }
 
-// Put associate the specified value with the specified key in this dictionary.
-func (d *SyncDict[K, V]) Put(key K, val V) V {
-	prev, ok := d.swap(key, val, false)
-	if ok {
-		return *prev
-	} else {
-		return d.zero
-	}
-}
-
-// Remove removes the key-value pair with the specified key.
-func (d *SyncDict[K, V]) Remove(key K) V {
+// LoadAndDelete removes the key-value pair with the specified key, and returns the previous value
+// and true if the key was present, like sync.Map's LoadAndDelete.
+func (d *SyncDict[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
 	read := d.loadReadOnly()
 	e, ok := read.M[key]
 	if !ok && read.Amended {
@@ -321,23 +406,78 @@ func (d *SyncDict[K, V]) Remove(key K) V {
 		d.mu.Unlock()
 	}
 	if ok {
-		vp, ok := e.Delete()
-		if ok {
-			return *vp
+		if vp, ok := e.Delete(); ok {
+			return *vp, true
+		}
+	}
+	return d.zero, false
+}
+
+// LoadOrStore returns the existing value for the key if present. Otherwise, it stores and returns
+// the given value. The loaded result is true if the value was loaded, false if stored, like
+// sync.Map's LoadOrStore.
+func (d *SyncDict[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	return d.PutIfAbsent(key, value)
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key. The remapping function may be called more
+// than once if the value is modified concurrently, so it should have no side effects.
+func (d *SyncDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// Put associate the specified value with the specified key in this dictionary.
+func (d *SyncDict[K, V]) Put(key K, val V) V {
+	prev, _ := d.Swap(key, val)
+	return prev
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *SyncDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Range calls f sequentially for each key and value present in the dictionary, and stops the
+// iteration if f returns false, like sync.Map's Range.
+func (d *SyncDict[K, V]) Range(f func(key K, value V) bool) {
+	read := d.loadPresentReadOnly()
+
+	for k, e := range read.M {
+		v, ok := e.Load(d.zero)
+		if !ok {
+			continue
+		}
+		if !f(k, v) {
+			break
 		}
-		return d.zero
 	}
-	return d.zero
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *SyncDict[K, V]) Remove(key K) V {
+	v, _ := d.LoadAndDelete(key)
+	return v
 }
 
 // Replace replaces the value for the specified key only if it is currently in this dictionary.
 func (d *SyncDict[K, V]) Replace(key K, val V) (V, bool) {
-	prev, ok := d.swap(key, val, true)
-	if ok {
-		return *prev, ok
-	} else {
-		return d.zero, ok
+	old, found := d.zero, false
+	d.compute(key, func(cur V, ok bool) (V, bool) {
+		old, found = cur, ok
+		if !ok {
+			return cur, false
+		}
+		return val, true
+	})
+
+	if !found {
+		return d.zero, false
 	}
+	return old, true
 }
 
 // Size returns the number of key-value pairs in this dictionary.
@@ -378,6 +518,16 @@ func (d *SyncDict[K, V]) String() string {
 	return buf.String()
 }
 
+// Swap swaps the value for the key and returns the previous value if any. The loaded result
+// reports whether the key was present, like sync.Map's Swap.
+func (d *SyncDict[K, V]) Swap(key K, value V) (previous V, loaded bool) {
+	prev, ok := d.swap(key, value)
+	if ok {
+		return *prev, true
+	}
+	return d.zero, false
+}
+
 // Values returns a slice that contains all the values in this dictionary.
 func (d *SyncDict[K, V]) Values() []V {
 	read := d.loadPresentReadOnly()
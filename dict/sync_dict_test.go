@@ -1,6 +1,9 @@
 package dict
 
 import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ghosind/collection"
@@ -20,6 +23,203 @@ func TestSyncDict(t *testing.T) {
 	testDict(a, syncDictConstructor)
 }
 
+func TestSyncDictSyncMapMethods(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDict[string, int]()
+
+	actual, loaded := d.LoadOrStore("a", 1)
+	a.NotTrueNow(loaded)
+	a.EqualNow(1, actual)
+	actual, loaded = d.LoadOrStore("a", 2)
+	a.TrueNow(loaded)
+	a.EqualNow(1, actual)
+
+	prev, loaded := d.Swap("a", 3)
+	a.TrueNow(loaded)
+	a.EqualNow(1, prev)
+	prev, loaded = d.Swap("b", 4)
+	a.NotTrueNow(loaded)
+	a.EqualNow(0, prev)
+
+	a.NotTrueNow(d.CompareAndSwap("a", 1, 5))
+	a.TrueNow(d.CompareAndSwap("a", 3, 5))
+	a.EqualNow(5, d.GetDefault("a", 0))
+	a.NotTrueNow(d.CompareAndSwap("c", 0, 1))
+	a.NotTrueNow(d.ContainsKey("c"))
+
+	a.NotTrueNow(d.CompareAndDelete("b", 5))
+	a.TrueNow(d.CompareAndDelete("b", 4))
+	a.NotTrueNow(d.ContainsKey("b"))
+
+	v, loaded := d.LoadAndDelete("a")
+	a.TrueNow(loaded)
+	a.EqualNow(5, v)
+	v, loaded = d.LoadAndDelete("a")
+	a.NotTrueNow(loaded)
+	a.EqualNow(0, v)
+	a.TrueNow(d.IsEmpty())
+}
+
+func TestSyncDictDeletedEntry(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDictFrom(map[string]int{"a": 1, "b": 2})
+
+	a.EqualNow(1, d.Remove("a"))
+	old, ok := d.Replace("a", 3)
+	a.NotTrueNow(ok)
+	a.EqualNow(0, old)
+	a.NotTrueNow(d.ContainsKey("a"))
+
+	a.EqualNow(0, d.Put("a", 4))
+	a.EqualNow(4, d.GetDefault("a", 0))
+
+	d.Remove("b")
+	prev, loaded := d.Swap("b", 5)
+	a.NotTrueNow(loaded)
+	a.EqualNow(0, prev)
+	a.EqualNow(5, d.GetDefault("b", 0))
+}
+
+func TestSyncDictComputeIfAbsentOnce(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDict[int, int]()
+
+	// the keys 0 to 49 are deleted entries of the read map, and the keys 50 to 99 are absent.
+	for i := 0; i < 50; i++ {
+		d.Put(i, i)
+	}
+	for i := 0; i < 100; i++ {
+		d.Get(i)
+	}
+	for i := 0; i < 50; i++ {
+		d.Remove(i)
+	}
+
+	calls := make([]int32, 100)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				d.ComputeIfAbsent(k, func(k int) int {
+					atomic.AddInt32(&calls[k], 1)
+					return k * 10
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	for k := 0; k < 100; k++ {
+		a.EqualNow(int32(1), calls[k], "the mapping function of key %d is called %d times", k, calls[k])
+		a.EqualNow(k*10, d.GetDefault(k, 0))
+	}
+}
+
+func TestSyncDictRange(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDict[int, int]()
+	for i := 0; i < 10; i++ {
+		d.Put(i, i*10)
+	}
+
+	visited := make(map[int]int)
+	d.Range(func(k, v int) bool {
+		visited[k] = v
+		return true
+	})
+	a.EqualNow(10, len(visited))
+	for k, v := range visited {
+		a.EqualNow(k*10, v)
+	}
+
+	count := 0
+	d.Range(func(k, v int) bool {
+		count++
+		return count < 3
+	})
+	a.EqualNow(3, count)
+}
+
+func TestSyncDictSyncMapParity(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDict[int, int]()
+	m := new(sync.Map)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		k, v, old := r.Intn(16), r.Intn(4), r.Intn(4)
+
+		switch r.Intn(5) {
+		case 0:
+			actual, loaded := d.LoadOrStore(k, v)
+			expect, expectLoaded := m.LoadOrStore(k, v)
+			a.EqualNow(expectLoaded, loaded)
+			a.EqualNow(expect, actual)
+		case 1:
+			value, loaded := d.LoadAndDelete(k)
+			expect, expectLoaded := m.LoadAndDelete(k)
+			a.EqualNow(expectLoaded, loaded)
+			if expectLoaded {
+				a.EqualNow(expect, value)
+			}
+		case 2:
+			prev, loaded := d.Swap(k, v)
+			expect, expectLoaded := m.Swap(k, v)
+			a.EqualNow(expectLoaded, loaded)
+			if expectLoaded {
+				a.EqualNow(expect, prev)
+			}
+		case 3:
+			a.EqualNow(m.CompareAndSwap(k, old, v), d.CompareAndSwap(k, old, v))
+		case 4:
+			a.EqualNow(m.CompareAndDelete(k, old), d.CompareAndDelete(k, old))
+		}
+	}
+
+	size := 0
+	m.Range(func(k, v any) bool {
+		size++
+		a.EqualNow(v, d.GetDefault(k.(int), -1))
+		return true
+	})
+	a.EqualNow(size, d.Size())
+}
+
+func TestSyncDictSyncMapMethodsConcurrent(t *testing.T) {
+	a := assert.New(t)
+	d := NewSyncDict[int, int]()
+
+	wg := sync.WaitGroup{}
+	stored := make([]int, 8)
+	deleted := make([]int, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if _, loaded := d.LoadOrStore(j, n); !loaded {
+					stored[n]++
+				}
+				if _, loaded := d.LoadAndDelete(j - 1); loaded {
+					deleted[n]++
+				}
+				d.Range(func(k, v int) bool {
+					return k < 10
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range stored {
+		total += stored[i] - deleted[i]
+	}
+	a.EqualNow(total, d.Size())
+}
+
 func BenchmarkSyncDict_Get(b *testing.B) {
 	benchmarkDict_Get(b, syncDictConstructor, true)
 }
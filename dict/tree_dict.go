@@ -0,0 +1,428 @@
+package dict
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// TreeDict is a dictionary implementation based on red-black tree, the key-value pairs are sorted
+// by the keys with the comparator. The dictionaries returned by HeadDict, SubDict and TailDict are
+// the views of the same tree that only contain the keys in their ranges.
+type TreeDict[K comparable, V any] struct {
+	tree *internal.RBView[K, V]
+}
+
+// NewTreeDict creates a new TreeDict with the specified comparator. The comparator returns a
+// negative number if a < b, zero if a == b, and a positive number if a > b.
+func NewTreeDict[K comparable, V any](cmp func(a, b K) int) *TreeDict[K, V] {
+	d := new(TreeDict[K, V])
+	d.tree = internal.NewRBView(internal.NewRBTree[K, V](cmp))
+
+	return d
+}
+
+// NewTreeDictFrom creates a new TreeDict with the specified comparator from the given map.
+func NewTreeDictFrom[K comparable, V any](cmp func(a, b K) int, m map[K]V) *TreeDict[K, V] {
+	d := NewTreeDict[K, V](cmp)
+
+	for k, v := range m {
+		d.tree.Insert(k, v)
+	}
+
+	return d
+}
+
+// Ceiling returns the least key greater than or equal to the specified key, or false if there is
+// no such key.
+func (d *TreeDict[K, V]) Ceiling(k K) (K, bool) {
+	return d.nodeKey(d.tree.Ceiling(k))
+}
+
+// Clear removes all key-value pairs in this dictionary.
+func (d *TreeDict[K, V]) Clear() {
+	d.tree.Clear()
+}
+
+// Clone returns a copy of this dictionary, the copy of a view is a new dictionary without the range
+// restriction.
+func (d *TreeDict[K, V]) Clone() collection.Dict[K, V] {
+	newDict := new(TreeDict[K, V])
+	newDict.tree = internal.NewRBView(d.tree.Clone())
+
+	return newDict
+}
+
+// CompareAndDelete removes the key-value pair with the specified key if its value is equal to the
+// old value, and returns true if the pair was removed.
+func (d *TreeDict[K, V]) CompareAndDelete(k K, old V) bool {
+	return internal.CompareAndDelete(d.compute, k, old)
+}
+
+// CompareAndSwap replaces the value for the specified key with the new value if its current value
+// is equal to the old value, and returns true if the value was swapped.
+func (d *TreeDict[K, V]) CompareAndSwap(k K, old, new V) bool {
+	return internal.CompareAndSwap(d.compute, k, old, new)
+}
+
+// Compute computes the new value for the specified key by the remapping function, and the pair is
+// removed if the remapping function returns false. It returns the new value and whether the key is
+// present after the computation.
+func (d *TreeDict[K, V]) Compute(k K, remapping func(K, V, bool) (V, bool)) (V, bool) {
+	return internal.Compute(d.compute, k, remapping)
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the specified key if
+// the key is not present, and returns the current value for the key.
+func (d *TreeDict[K, V]) ComputeIfAbsent(k K, mapping func(K) V) V {
+	return internal.ComputeIfAbsent(d.compute, k, mapping)
+}
+
+// ComputeIfPresent replaces the value for the specified key with the value computed by the
+// remapping function if the key is present, and the pair is removed if the remapping function
+// returns false. It returns the new value and whether the key is present after the computation.
+func (d *TreeDict[K, V]) ComputeIfPresent(k K, remapping func(K, V) (V, bool)) (V, bool) {
+	return internal.ComputeIfPresent(d.compute, k, remapping)
+}
+
+// ContainsKey returns true if this dictionary contains a key-value pair with the specified key.
+func (d *TreeDict[K, V]) ContainsKey(k K) bool {
+	return d.tree.Find(k) != nil
+}
+
+// Equals compares this dictionary with the object pass from parameter.
+func (d *TreeDict[K, V]) Equals(o any) bool {
+	od, ok := o.(*TreeDict[K, V])
+	if !ok {
+		return false
+	}
+
+	if d.Size() != od.Size() {
+		return false
+	}
+
+	for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+		on := od.tree.Find(n.Key)
+		if on == nil {
+			return false
+		}
+
+		if !internal.Equal(n.Value, on.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FirstEntry returns the key-value pair with the lowest key in this dictionary, or false if this
+// dictionary is empty.
+func (d *TreeDict[K, V]) FirstEntry() (K, V, bool) {
+	return d.nodeEntry(d.tree.First())
+}
+
+// FirstKey returns the first (lowest) key in this dictionary, or false if this dictionary is empty.
+func (d *TreeDict[K, V]) FirstKey() (K, bool) {
+	return d.nodeKey(d.tree.First())
+}
+
+// Floor returns the greatest key less than or equal to the specified key, or false if there is no
+// such key.
+func (d *TreeDict[K, V]) Floor(k K) (K, bool) {
+	return d.nodeKey(d.tree.Floor(k))
+}
+
+// ForEach performs the given handler for each key-value pairs in the dictionary in ascending key
+// order until all pairs have been processed or the handler returns an error.
+func (d *TreeDict[K, V]) ForEach(handler func(K, V) error) error {
+	for n := d.tree.First(); n != nil; {
+		next := d.tree.Next(n)
+		if err := handler(n.Key, n.Value); err != nil {
+			return err
+		}
+		n = next
+	}
+
+	return nil
+}
+
+// Get returns the value which associated to the specified key.
+func (d *TreeDict[K, V]) Get(k K) (V, bool) {
+	n := d.tree.Find(k)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.Value, true
+}
+
+// GetDefault returns the value associated with the specified key, and returns the default value if
+// this dictionary contains no pair with the key.
+func (d *TreeDict[K, V]) GetDefault(k K, defaultVal V) V {
+	n := d.tree.Find(k)
+	if n == nil {
+		return defaultVal
+	}
+
+	return n.Value
+}
+
+// HeadDict returns a view of the portion of this dictionary whose keys are strictly less than
+// toKey. The changes in the view are reflected in this dictionary and vice versa, and putting a key
+// out of the range into the view panics with ErrKeyOutOfRange.
+func (d *TreeDict[K, V]) HeadDict(toKey K) collection.SortedDict[K, V] {
+	var zero K
+	return d.view(zero, false, toKey, true)
+}
+
+// Higher returns the least key strictly greater than the specified key, or false if there is no
+// such key.
+func (d *TreeDict[K, V]) Higher(k K) (K, bool) {
+	return d.nodeKey(d.tree.Higher(k))
+}
+
+// IsEmpty returns true if this dictionary is empty.
+func (d *TreeDict[K, V]) IsEmpty() bool {
+	return d.Size() == 0
+}
+
+// Keys returns a slice that contains all the keys in this dictionary in ascending order.
+func (d *TreeDict[K, V]) Keys() []K {
+	keys := make([]K, 0, d.tree.Size())
+	for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+		keys = append(keys, n.Key)
+	}
+
+	return keys
+}
+
+// LastEntry returns the key-value pair with the highest key in this dictionary, or false if this
+// dictionary is empty.
+func (d *TreeDict[K, V]) LastEntry() (K, V, bool) {
+	return d.nodeEntry(d.tree.Last())
+}
+
+// LastKey returns the last (highest) key in this dictionary, or false if this dictionary is empty.
+func (d *TreeDict[K, V]) LastKey() (K, bool) {
+	return d.nodeKey(d.tree.Last())
+}
+
+// Lower returns the greatest key strictly less than the specified key, or false if there is no
+// such key.
+func (d *TreeDict[K, V]) Lower(k K) (K, bool) {
+	return d.nodeKey(d.tree.Lower(k))
+}
+
+// Merge associates the specified value with the specified key if the key is not present, or
+// replaces the current value with the result of the remapping function of the current value and the
+// specified value. It returns the new value for the key.
+func (d *TreeDict[K, V]) Merge(k K, v V, remapping func(V, V) V) V {
+	return internal.Merge(d.compute, k, v, remapping)
+}
+
+// PollFirst removes and returns the key-value pair with the lowest key in this dictionary, or false
+// if this dictionary is empty.
+func (d *TreeDict[K, V]) PollFirst() (K, V, bool) {
+	n := d.tree.First()
+	if n != nil {
+		d.tree.Delete(n)
+	}
+
+	return d.nodeEntry(n)
+}
+
+// PollLast removes and returns the key-value pair with the highest key in this dictionary, or false
+// if this dictionary is empty.
+func (d *TreeDict[K, V]) PollLast() (K, V, bool) {
+	n := d.tree.Last()
+	if n != nil {
+		d.tree.Delete(n)
+	}
+
+	return d.nodeEntry(n)
+}
+
+// Put associate the specified value with the specified key in this dictionary.
+func (d *TreeDict[K, V]) Put(k K, v V) V {
+	n, inserted := d.tree.Insert(k, v)
+	if inserted {
+		var zero V
+		return zero
+	}
+
+	old := n.Value
+	n.Value = v
+
+	return old
+}
+
+// PutIfAbsent associates the specified value with the specified key if the key is not present. It
+// returns the current value and true if the key is present, or returns the specified value and
+// false.
+func (d *TreeDict[K, V]) PutIfAbsent(k K, v V) (V, bool) {
+	return internal.PutIfAbsent(d.compute, k, v)
+}
+
+// Remove removes the key-value pair with the specified key.
+func (d *TreeDict[K, V]) Remove(k K) V {
+	n := d.tree.Find(k)
+	if n == nil {
+		var zero V
+		return zero
+	}
+
+	d.tree.Delete(n)
+
+	return n.Value
+}
+
+// Replace replaces the value for the specified key only if it is currently in this dictionary.
+func (d *TreeDict[K, V]) Replace(k K, v V) (V, bool) {
+	n := d.tree.Find(k)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	old := n.Value
+	n.Value = v
+
+	return old, true
+}
+
+// Size returns the number of key-value pairs in this dictionary.
+func (d *TreeDict[K, V]) Size() int {
+	return d.tree.Size()
+}
+
+// String returns the string representation of this dictionary.
+func (d *TreeDict[K, V]) String() string {
+	buf := bytes.NewBufferString("dict[")
+	first := true
+	for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+		if !first {
+			buf.WriteString(" ")
+		}
+		first = false
+		buf.WriteString(internal.ValueString(n.Key))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(n.Value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// SubDict returns a view of the portion of this dictionary whose keys range from fromKey,
+// inclusive, to toKey, exclusive. The changes in the view are reflected in this dictionary and vice
+// versa, and putting a key out of the range into the view panics with ErrKeyOutOfRange.
+func (d *TreeDict[K, V]) SubDict(fromKey, toKey K) collection.SortedDict[K, V] {
+	return d.view(fromKey, true, toKey, true)
+}
+
+// TailDict returns a view of the portion of this dictionary whose keys are greater than or equal
+// to fromKey. The changes in the view are reflected in this dictionary and vice versa, and putting
+// a key out of the range into the view panics with ErrKeyOutOfRange.
+func (d *TreeDict[K, V]) TailDict(fromKey K) collection.SortedDict[K, V] {
+	var zero K
+	return d.view(fromKey, true, zero, false)
+}
+
+// Values returns a slice that contains all the values in this dictionary in ascending key order.
+func (d *TreeDict[K, V]) Values() []V {
+	values := make([]V, 0, d.tree.Size())
+	for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+		values = append(values, n.Value)
+	}
+
+	return values
+}
+
+// MarshalJSON marshals the TreeDict as a JSON object, the members are in ascending key order.
+func (d *TreeDict[K, V]) MarshalJSON() ([]byte, error) {
+	return internal.MarshalJSONObject(d.Keys(), d.Values())
+}
+
+// UnmarshalJSON unmarshals a JSON object into the TreeDict. The TreeDict must be created with a
+// comparator before unmarshaling.
+func (d *TreeDict[K, V]) UnmarshalJSON(b []byte) error {
+	if d.tree == nil {
+		return collection.ErrNoComparator
+	}
+
+	var tmp map[K]V
+	if err := json.Unmarshal(b, &tmp); err != nil {
+		return err
+	}
+	for k := range tmp {
+		if !d.tree.InRange(k) {
+			return collection.ErrKeyOutOfRange
+		}
+	}
+
+	d.tree.Clear()
+	for k, v := range tmp {
+		d.tree.Insert(k, v)
+	}
+
+	return nil
+}
+
+// compute computes the new value for the specified key by the remapping function, it is the
+// implementation of the atomic compute operations.
+func (d *TreeDict[K, V]) compute(k K, remapping func(V, bool) (V, bool)) (V, bool) {
+	var old V
+	n := d.tree.Find(k)
+	if n != nil {
+		old = n.Value
+	}
+
+	v, keep := remapping(old, n != nil)
+	if !keep {
+		if n != nil {
+			d.tree.Delete(n)
+		}
+		var zero V
+		return zero, false
+	}
+
+	if n != nil {
+		n.Value = v
+	} else {
+		d.tree.Insert(k, v)
+	}
+
+	return v, true
+}
+
+// nodeEntry returns the key and value of the node, or false if the node is nil.
+func (d *TreeDict[K, V]) nodeEntry(n *internal.RBNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+
+	return n.Key, n.Value, true
+}
+
+// nodeKey returns the key of the node, or false if the node is nil.
+func (d *TreeDict[K, V]) nodeKey(n *internal.RBNode[K, V]) (K, bool) {
+	if n == nil {
+		var zero K
+		return zero, false
+	}
+
+	return n.Key, true
+}
+
+// view returns a view of this dictionary with the specified bounds, the bounds of the view are
+// narrowed to the range of this dictionary.
+func (d *TreeDict[K, V]) view(fromKey K, hasFrom bool, toKey K, hasTo bool) *TreeDict[K, V] {
+	newDict := new(TreeDict[K, V])
+	newDict.tree = d.tree.Sub(fromKey, hasFrom, toKey, hasTo)
+
+	return newDict
+}
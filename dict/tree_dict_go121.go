@@ -0,0 +1,10 @@
+//go:build go1.21
+
+package dict
+
+import "cmp"
+
+// NewOrderedTreeDict creates a new TreeDict that sorts the keys in their natural order.
+func NewOrderedTreeDict[K cmp.Ordered, V any]() *TreeDict[K, V] {
+	return NewTreeDict[K, V](cmp.Compare[K])
+}
@@ -0,0 +1,58 @@
+//go:build go1.23
+
+package dict
+
+import "iter"
+
+// Iter returns an iterator of all elements in this dictionary in ascending key order.
+func (d *TreeDict[K, V]) Iter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := d.tree.First(); n != nil; {
+			next := d.tree.Next(n)
+			if !yield(n.Key, n.Value) {
+				break
+			}
+			n = next
+		}
+	}
+}
+
+// KeysIter returns an iterator of all keys in this dictionary in ascending order.
+func (d *TreeDict[K, V]) KeysIter() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for n := d.tree.First(); n != nil; {
+			next := d.tree.Next(n)
+			if !yield(n.Key) {
+				break
+			}
+			n = next
+		}
+	}
+}
+
+// ValuesIter returns an iterator of all values in this dictionary in ascending key order.
+func (d *TreeDict[K, V]) ValuesIter() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for n := d.tree.First(); n != nil; {
+			next := d.tree.Next(n)
+			if !yield(n.Value) {
+				break
+			}
+			n = next
+		}
+	}
+}
+
+// DescendingIter returns an iterator of all key-value pairs in this dictionary in descending key
+// order.
+func (d *TreeDict[K, V]) DescendingIter() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := d.tree.Last(); n != nil; {
+			prev := d.tree.Prev(n)
+			if !yield(n.Key, n.Value) {
+				break
+			}
+			n = prev
+		}
+	}
+}
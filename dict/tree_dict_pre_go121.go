@@ -0,0 +1,10 @@
+//go:build !go1.21
+
+package dict
+
+import "github.com/ghosind/collection/internal"
+
+// NewOrderedTreeDict creates a new TreeDict that sorts the keys in their natural order.
+func NewOrderedTreeDict[K internal.Ordered, V any]() *TreeDict[K, V] {
+	return NewTreeDict[K, V](internal.Compare[K])
+}
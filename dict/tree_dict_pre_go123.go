@@ -0,0 +1,27 @@
+//go:build !go1.23
+
+package dict
+
+// KeysIter returns a channel iterator of all keys in this dictionary in ascending order.
+func (d *TreeDict[K, V]) KeysIter() <-chan K {
+	ch := make(chan K)
+	go func() {
+		for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+			ch <- n.Key
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// ValuesIter returns a channel iterator of all values in this dictionary in ascending key order.
+func (d *TreeDict[K, V]) ValuesIter() <-chan V {
+	ch := make(chan V)
+	go func() {
+		for n := d.tree.First(); n != nil; n = d.tree.Next(n) {
+			ch <- n.Value
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,231 @@
+package dict
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func treeDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	if len(initData) == 0 || len(initData[0]) == 0 {
+		return NewTreeDict[string, string](strings.Compare)
+	}
+	return NewTreeDictFrom(strings.Compare, initData[0])
+}
+
+func TestTreeDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, treeDictConstructor)
+}
+
+func BenchmarkTreeDict_Get(b *testing.B) {
+	benchmarkDict_Get(b, treeDictConstructor, false)
+}
+
+func BenchmarkTreeDict_Put(b *testing.B) {
+	benchmarkDict_Put(b, treeDictConstructor, false)
+}
+
+func lockedTreeDictConstructor(initData ...map[string]string) collection.Dict[string, string] {
+	return NewLockDict(treeDictConstructor(initData...))
+}
+
+func TestLockedTreeDict(t *testing.T) {
+	a := assert.New(t)
+
+	testDict(a, lockedTreeDictConstructor)
+}
+
+func sortedTreeDictConstructor(keys ...int) collection.SortedDict[int, int] {
+	d := NewOrderedTreeDict[int, int]()
+	for _, k := range keys {
+		d.Put(k, k*10)
+	}
+	return d
+}
+
+func TestSortedTreeDict(t *testing.T) {
+	a := assert.New(t)
+
+	testSortedDict(a, sortedTreeDictConstructor)
+}
+
+func lockedSortedTreeDictConstructor(keys ...int) collection.SortedDict[int, int] {
+	return NewLockSortedDict[int, int](sortedTreeDictConstructor(keys...))
+}
+
+func TestLockedSortedTreeDict(t *testing.T) {
+	a := assert.New(t)
+
+	testSortedDict(a, lockedSortedTreeDictConstructor)
+	testDict(a, func(initData ...map[string]string) collection.Dict[string, string] {
+		return NewLockSortedDict[string, string](treeDictConstructor(initData...).(*TreeDict[string, string]))
+	})
+}
+
+func lockedNavigableTreeDictConstructor(keys ...int) collection.SortedDict[int, int] {
+	return NewLockNavigableDict[int, int](sortedTreeDictConstructor(keys...).(*TreeDict[int, int]))
+}
+
+func TestLockedNavigableTreeDict(t *testing.T) {
+	a := assert.New(t)
+
+	testSortedDict(a, lockedNavigableTreeDictConstructor)
+	testDict(a, func(initData ...map[string]string) collection.Dict[string, string] {
+		return NewLockNavigableDict[string, string](treeDictConstructor(initData...).(*TreeDict[string, string]))
+	})
+
+	d := NewLockNavigableDict[int, int](NewOrderedTreeDict[int, int]())
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		d.Put(k, k)
+	}
+	testTreeDictKey(a, 20, true)(d.Floor(25))
+	testTreeDictKey(a, 30, true)(d.Ceiling(25))
+	testTreeDictKey(a, 20, true)(d.Lower(30))
+	testTreeDictKey(a, 0, false)(d.Higher(50))
+
+	sub, ok := d.SubDict(20, 40).(*LockNavigableDict[int, int])
+	a.TrueNow(ok)
+	a.TrueNow(sub.mu == d.mu)
+	testTreeDictKey(a, 30, true)(sub.Floor(45))
+	testTreeDictKey(a, 20, true)(sub.Ceiling(5))
+	testTreeDictKey(a, 0, false)(sub.Higher(30))
+	testTreeDictKey(a, 0, false)(sub.Lower(20))
+
+	head, ok := d.HeadDict(40).(*LockNavigableDict[int, int])
+	a.TrueNow(ok)
+	tail, ok := head.TailDict(20).(*LockNavigableDict[int, int])
+	a.TrueNow(ok)
+	a.TrueNow(tail.mu == d.mu)
+	a.TrueNow(tail.Equals(sub))
+
+	cloned, ok := d.Clone().(*LockNavigableDict[int, int])
+	a.TrueNow(ok)
+	a.NotTrueNow(cloned.mu == d.mu)
+	a.TrueNow(cloned.Equals(d))
+	a.NotTrueNow(cloned.Equals(NewLockSortedDict[int, int](NewOrderedTreeDict[int, int]())))
+}
+
+func TestLockedSortedTreeDictView(t *testing.T) {
+	a := assert.New(t)
+	d := NewLockSortedDict[int, int](NewOrderedTreeDict[int, int]())
+
+	head := d.HeadDict(50).(*LockSortedDict[int, int])
+	sub := head.SubDict(10, 30).(*LockSortedDict[int, int])
+	tail := d.TailDict(50).(*LockSortedDict[int, int])
+	a.TrueNow(head.mu == d.mu)
+	a.TrueNow(sub.mu == d.mu)
+	a.TrueNow(tail.mu == d.mu)
+	a.NotTrueNow(d.Clone().(*LockSortedDict[int, int]).mu == d.mu)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(3)
+		go func(i int) {
+			defer wg.Done()
+			d.Put(i, i)
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			tail.Put(50+i, i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			sub.Size()
+			head.Get(0)
+		}()
+	}
+	wg.Wait()
+
+	a.EqualNow(150, d.Size())
+	a.EqualNow(20, sub.Size())
+	a.TrueNow(head.Equals(head.HeadDict(50)))
+}
+
+func TestTreeDictOrder(t *testing.T) {
+	a := assert.New(t)
+	d := NewOrderedTreeDict[int, string]()
+
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		d.Put(k, strings.Repeat("x", k/10))
+	}
+
+	a.EqualNow([]int{10, 20, 30, 40, 50}, d.Keys())
+	a.EqualNow([]string{"x", "xx", "xxx", "xxxx", "xxxxx"}, d.Values())
+	a.EqualNow("dict[10: x 20: xx 30: xxx 40: xxxx 50: xxxxx]", d.String())
+
+	keys := make([]int, 0, d.Size())
+	err := d.ForEach(func(k int, v string) error {
+		keys = append(keys, k)
+		return nil
+	})
+	a.NilNow(err)
+	a.EqualNow(d.Keys(), keys)
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow(`{"10":"x","20":"xx","30":"xxx","40":"xxxx","50":"xxxxx"}`, string(b))
+
+	var zero TreeDict[int, string]
+	a.EqualNow(collection.ErrNoComparator, zero.UnmarshalJSON(b))
+}
+
+func TestTreeDictNavigation(t *testing.T) {
+	a := assert.New(t)
+	d := NewOrderedTreeDict[int, int]()
+
+	_, ok := d.FirstKey()
+	a.NotTrueNow(ok)
+	_, ok = d.LastKey()
+	a.NotTrueNow(ok)
+
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		d.Put(k, k)
+	}
+
+	testTreeDictKey(a, 10, true)(d.FirstKey())
+	testTreeDictKey(a, 50, true)(d.LastKey())
+	testTreeDictKey(a, 20, true)(d.Floor(25))
+	testTreeDictKey(a, 30, true)(d.Floor(30))
+	testTreeDictKey(a, 0, false)(d.Floor(5))
+	testTreeDictKey(a, 30, true)(d.Ceiling(25))
+	testTreeDictKey(a, 30, true)(d.Ceiling(30))
+	testTreeDictKey(a, 0, false)(d.Ceiling(55))
+	testTreeDictKey(a, 20, true)(d.Lower(30))
+	testTreeDictKey(a, 0, false)(d.Lower(10))
+	testTreeDictKey(a, 40, true)(d.Higher(30))
+	testTreeDictKey(a, 0, false)(d.Higher(50))
+
+	sub := d.SubDict(20, 40).(*TreeDict[int, int])
+	testTreeDictKey(a, 20, true)(sub.FirstKey())
+	testTreeDictKey(a, 30, true)(sub.LastKey())
+	testTreeDictKey(a, 30, true)(sub.Floor(45))
+	testTreeDictKey(a, 0, false)(sub.Floor(15))
+	testTreeDictKey(a, 20, true)(sub.Ceiling(5))
+	testTreeDictKey(a, 0, false)(sub.Ceiling(35))
+	testTreeDictKey(a, 30, true)(sub.Lower(50))
+	testTreeDictKey(a, 0, false)(sub.Lower(20))
+	testTreeDictKey(a, 20, true)(sub.Higher(10))
+	testTreeDictKey(a, 0, false)(sub.Higher(30))
+
+	clone := sub.Clone()
+	clone.Put(60, 60)
+	a.EqualNow([]int{20, 30, 60}, clone.Keys())
+	a.EqualNow([]int{10, 20, 30, 40, 50}, d.Keys())
+
+	a.EqualNow(collection.ErrKeyOutOfRange, json.Unmarshal([]byte(`{"25":25,"45":45}`), sub))
+	a.NilNow(json.Unmarshal([]byte(`{"25":25}`), sub))
+	a.EqualNow([]int{10, 25, 40, 50}, d.Keys())
+}
+
+func testTreeDictKey(a *assert.Assertion, expected int, expectedOk bool) func(int, bool) {
+	return func(k int, ok bool) {
+		a.EqualNow(expectedOk, ok)
+		a.EqualNow(expected, k)
+	}
+}
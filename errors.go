@@ -5,4 +5,24 @@ import "errors"
 var (
 	// ErrOutOfBounds indicates that the index is out of the valid range.
 	ErrOutOfBounds = errors.New("index out of bounds")
+	// ErrBufferFull indicates that the fixed capacity buffer has no remaining space.
+	ErrBufferFull = errors.New("buffer is full")
+	// ErrDuplicateValue indicates that the value is already associated with another key in the
+	// dictionary that requires unique values.
+	ErrDuplicateValue = errors.New("duplicate value")
+	// ErrInvalidCapacity indicates that the capacity of the bounded collection is not positive.
+	ErrInvalidCapacity = errors.New("invalid capacity")
+	// ErrInvalidInterval indicates that the interval of the periodic task is not positive.
+	ErrInvalidInterval = errors.New("invalid interval")
+	// ErrInvalidSize indicates that the size of the chunks or windows is not positive.
+	ErrInvalidSize = errors.New("invalid size")
+	// ErrKeyOutOfRange indicates that the key or element is outside the range of the sorted view.
+	ErrKeyOutOfRange = errors.New("key out of range")
+	// ErrNoComparator indicates that the ordered collection was not initialized with a comparator.
+	ErrNoComparator = errors.New("no comparator")
+	// ErrQueueFull indicates that the bounded queue has no remaining capacity.
+	ErrQueueFull = errors.New("queue is full")
+	// ErrWorkerPanic indicates that a function performed by a worker of the parallel operation
+	// panicked.
+	ErrWorkerPanic = errors.New("worker panicked")
 )
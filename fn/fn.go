@@ -0,0 +1,207 @@
+package fn
+
+import (
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/dict"
+	"github.com/ghosind/collection/list"
+)
+
+// Pair is a pair of two values, it is the element type of the list returned by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Chunk splits the elements of the iterable into the lists of the specified size, the last list
+// contains the remaining elements if the number of the elements is not a multiple of the size. It
+// panics with ErrInvalidSize if the size is not positive.
+func Chunk[T any](it collection.Iterable[T], size int) *list.ArrayList[collection.List[T]] {
+	if size <= 0 {
+		panic(collection.ErrInvalidSize)
+	}
+
+	chunks := list.NewArrayList[collection.List[T]]()
+	var chunk *list.ArrayList[T]
+	each(it, func(e T) bool {
+		if chunk == nil || chunk.Size() == size {
+			chunk = list.NewArrayList[T]()
+			chunks.Add(chunk)
+		}
+		chunk.Add(e)
+		return true
+	})
+
+	return chunks
+}
+
+// Distinct returns a list that contains the distinct elements of the iterable, the elements are
+// kept in the order of their first occurrences.
+func Distinct[T comparable](it collection.Iterable[T]) *list.ArrayList[T] {
+	seen := make(map[T]struct{})
+	result := list.NewArrayList[T]()
+	each(it, func(e T) bool {
+		if _, ok := seen[e]; !ok {
+			seen[e] = struct{}{}
+			result.Add(e)
+		}
+		return true
+	})
+
+	return result
+}
+
+// Filter returns a list that contains the elements of the iterable that satisfy the predicate.
+func Filter[T any](it collection.Iterable[T], predicate func(T) bool) *list.ArrayList[T] {
+	result := list.NewArrayList[T]()
+	each(it, func(e T) bool {
+		if predicate(e) {
+			result.Add(e)
+		}
+		return true
+	})
+
+	return result
+}
+
+// FlatMap returns a list that contains the elements of the slices returned by the mapper function
+// for each element of the iterable.
+func FlatMap[T, R any](it collection.Iterable[T], mapper func(T) []R) *list.ArrayList[R] {
+	result := list.NewArrayList[R]()
+	each(it, func(e T) bool {
+		result.AddAll(mapper(e)...)
+		return true
+	})
+
+	return result
+}
+
+// Fold accumulates the elements of the iterable into a value by the folder function, starting
+// with the initial value.
+func Fold[T, R any](it collection.Iterable[T], initial R, folder func(R, T) R) R {
+	acc := initial
+	each(it, func(e T) bool {
+		acc = folder(acc, e)
+		return true
+	})
+
+	return acc
+}
+
+// GroupBy groups the elements of the iterable by the keys returned by the key function, the
+// elements in each group are kept in the iteration order.
+func GroupBy[T any, K comparable](
+	it collection.Iterable[T],
+	key func(T) K,
+) *dict.HashDict[K, collection.List[T]] {
+	groups := dict.NewHashDict[K, collection.List[T]]()
+	each(it, func(e T) bool {
+		k := key(e)
+		group, ok := groups.Get(k)
+		if !ok {
+			group = list.NewArrayList[T]()
+			groups.Put(k, group)
+		}
+		group.Add(e)
+		return true
+	})
+
+	return groups
+}
+
+// Map returns a list that contains the results of applying the mapper function to the elements of
+// the iterable.
+func Map[T, R any](it collection.Iterable[T], mapper func(T) R) *list.ArrayList[R] {
+	result := list.NewArrayList[R]()
+	each(it, func(e T) bool {
+		result.Add(mapper(e))
+		return true
+	})
+
+	return result
+}
+
+// Partition splits the elements of the iterable into two lists, the first list contains the
+// elements that satisfy the predicate, and the second list contains the others.
+func Partition[T any](
+	it collection.Iterable[T],
+	predicate func(T) bool,
+) (*list.ArrayList[T], *list.ArrayList[T]) {
+	matched := list.NewArrayList[T]()
+	unmatched := list.NewArrayList[T]()
+	each(it, func(e T) bool {
+		if predicate(e) {
+			matched.Add(e)
+		} else {
+			unmatched.Add(e)
+		}
+		return true
+	})
+
+	return matched, unmatched
+}
+
+// Reduce reduces the elements of the iterable into a value by the reducer function, starting with
+// the first element. It returns false if the iterable has no elements.
+func Reduce[T any](it collection.Iterable[T], reducer func(T, T) T) (T, bool) {
+	var acc T
+	found := false
+	each(it, func(e T) bool {
+		if found {
+			acc = reducer(acc, e)
+		} else {
+			acc = e
+			found = true
+		}
+		return true
+	})
+
+	return acc, found
+}
+
+// Window returns the sliding windows of the specified size over the elements of the iterable, each
+// window starts from the next element of the previous window. It returns an empty list if the
+// iterable has fewer elements than the size, and panics with ErrInvalidSize if the size is not
+// positive.
+func Window[T any](it collection.Iterable[T], size int) *list.ArrayList[collection.List[T]] {
+	if size <= 0 {
+		panic(collection.ErrInvalidSize)
+	}
+
+	windows := list.NewArrayList[collection.List[T]]()
+	buf := make([]T, 0, size)
+	each(it, func(e T) bool {
+		if len(buf) == size {
+			buf = append(buf[:0], buf[1:]...)
+		}
+		buf = append(buf, e)
+		if len(buf) == size {
+			windows.Add(list.NewArrayListFrom(buf...))
+		}
+		return true
+	})
+
+	return windows
+}
+
+// Zip returns a list of the pairs of the elements at the same positions of the two iterables, the
+// length of the list is the length of the shorter iterable.
+func Zip[A, B any](a collection.Iterable[A], b collection.Iterable[B]) *list.ArrayList[Pair[A, B]] {
+	second := make([]B, 0)
+	each(b, func(e B) bool {
+		second = append(second, e)
+		return true
+	})
+
+	result := list.NewArrayList[Pair[A, B]]()
+	i := 0
+	each(a, func(e A) bool {
+		if i >= len(second) {
+			return false
+		}
+		result.Add(Pair[A, B]{First: e, Second: second[i]})
+		i++
+		return true
+	})
+
+	return result
+}
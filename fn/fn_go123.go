@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package fn
+
+import "github.com/ghosind/collection"
+
+// each performs the handler for each element of the iterable until the handler returns false.
+func each[T any](it collection.Iterable[T], handler func(T) bool) {
+	for e := range it.Iter() {
+		if !handler(e) {
+			return
+		}
+	}
+}
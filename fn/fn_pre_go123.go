@@ -0,0 +1,16 @@
+//go:build !go1.23
+
+package fn
+
+import "github.com/ghosind/collection"
+
+// each performs the handler for each element of the iterable until the handler returns false. The
+// channel is always drained, so the goroutine that sends the elements can exit.
+func each[T any](it collection.Iterable[T], handler func(T) bool) {
+	ok := true
+	for e := range it.Iter() {
+		if ok {
+			ok = handler(e)
+		}
+	}
+}
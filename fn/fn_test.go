@@ -0,0 +1,155 @@
+package fn
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/go-assert"
+)
+
+func TestChunk(t *testing.T) {
+	a := assert.New(t)
+
+	chunks := Chunk[int](list.NewArrayListFrom(1, 2, 3, 4, 5), 2)
+	a.EqualNow(3, chunks.Size())
+	a.EqualNow([]int{1, 2}, chunks.Get(0).ToSlice())
+	a.EqualNow([]int{3, 4}, chunks.Get(1).ToSlice())
+	a.EqualNow([]int{5}, chunks.Get(2).ToSlice())
+
+	a.TrueNow(Chunk[int](list.NewArrayList[int](), 2).IsEmpty())
+	a.PanicOfNow(func() {
+		Chunk[int](list.NewArrayList[int](), 0)
+	}, collection.ErrInvalidSize)
+}
+
+func TestDistinct(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow([]int{3, 1, 2}, Distinct[int](list.NewArrayListFrom(3, 1, 3, 2, 1)).ToSlice())
+	a.TrueNow(Distinct[int](list.NewArrayList[int]()).IsEmpty())
+}
+
+func TestFilter(t *testing.T) {
+	a := assert.New(t)
+
+	evens := Filter[int](list.NewArrayListFrom(1, 2, 3, 4, 5, 6), func(e int) bool {
+		return e%2 == 0
+	})
+	a.EqualNow([]int{2, 4, 6}, evens.ToSlice())
+}
+
+func TestFlatMap(t *testing.T) {
+	a := assert.New(t)
+
+	result := FlatMap[int](list.NewArrayListFrom(1, 2, 3), func(e int) []string {
+		s := make([]string, 0, e)
+		for i := 0; i < e; i++ {
+			s = append(s, strconv.Itoa(e))
+		}
+		return s
+	})
+	a.EqualNow([]string{"1", "2", "2", "3", "3", "3"}, result.ToSlice())
+}
+
+func TestFold(t *testing.T) {
+	a := assert.New(t)
+
+	s := Fold[int](list.NewArrayListFrom(1, 2, 3), "", func(acc string, e int) string {
+		return acc + strconv.Itoa(e)
+	})
+	a.EqualNow("123", s)
+	a.EqualNow(10, Fold[int](list.NewArrayList[int](), 10, func(acc, e int) int {
+		return acc + e
+	}))
+}
+
+func TestGroupBy(t *testing.T) {
+	a := assert.New(t)
+
+	groups := GroupBy[string](
+		list.NewArrayListFrom("apple", "avocado", "banana", "cherry", "blueberry"),
+		func(e string) byte {
+			return e[0]
+		},
+	)
+	a.EqualNow(3, groups.Size())
+	a.EqualNow([]string{"apple", "avocado"}, groups.GetDefault('a', nil).ToSlice())
+	a.EqualNow([]string{"banana", "blueberry"}, groups.GetDefault('b', nil).ToSlice())
+	a.EqualNow([]string{"cherry"}, groups.GetDefault('c', nil).ToSlice())
+}
+
+func TestMap(t *testing.T) {
+	a := assert.New(t)
+
+	result := Map[int](list.NewArrayListFrom(1, 2, 3), func(e int) string {
+		return strconv.Itoa(e * 10)
+	})
+	a.EqualNow([]string{"10", "20", "30"}, result.ToSlice())
+
+	lengths := Map[string](set.NewHashSetFrom("a", "bb", "ccc"), func(e string) int {
+		return len(e)
+	}).ToSlice()
+	sort.Ints(lengths)
+	a.EqualNow([]int{1, 2, 3}, lengths)
+}
+
+func TestPartition(t *testing.T) {
+	a := assert.New(t)
+
+	matched, unmatched := Partition[int](list.NewArrayListFrom(1, 2, 3, 4, 5), func(e int) bool {
+		return e > 2
+	})
+	a.EqualNow([]int{3, 4, 5}, matched.ToSlice())
+	a.EqualNow([]int{1, 2}, unmatched.ToSlice())
+}
+
+func TestReduce(t *testing.T) {
+	a := assert.New(t)
+
+	sum, ok := Reduce[int](list.NewArrayListFrom(1, 2, 3, 4), func(acc, e int) int {
+		return acc + e
+	})
+	a.TrueNow(ok)
+	a.EqualNow(10, sum)
+
+	v, ok := Reduce[int](list.NewArrayListFrom(5), func(acc, e int) int {
+		return acc + e
+	})
+	a.TrueNow(ok)
+	a.EqualNow(5, v)
+
+	_, ok = Reduce[int](list.NewArrayList[int](), func(acc, e int) int {
+		return acc + e
+	})
+	a.NotTrueNow(ok)
+}
+
+func TestWindow(t *testing.T) {
+	a := assert.New(t)
+
+	windows := Window[int](list.NewArrayListFrom(1, 2, 3, 4), 3)
+	a.EqualNow(2, windows.Size())
+	a.EqualNow([]int{1, 2, 3}, windows.Get(0).ToSlice())
+	a.EqualNow([]int{2, 3, 4}, windows.Get(1).ToSlice())
+
+	a.TrueNow(Window[int](list.NewArrayListFrom(1, 2), 3).IsEmpty())
+	a.EqualNow(2, Window[int](list.NewArrayListFrom(1, 2), 1).Size())
+	a.PanicOfNow(func() {
+		Window[int](list.NewArrayList[int](), -1)
+	}, collection.ErrInvalidSize)
+}
+
+func TestZip(t *testing.T) {
+	a := assert.New(t)
+
+	pairs := Zip[int, string](list.NewArrayListFrom(1, 2, 3), list.NewArrayListFrom("a", "b"))
+	a.EqualNow([]Pair[int, string]{{1, "a"}, {2, "b"}}, pairs.ToSlice())
+
+	pairs = Zip[int, string](list.NewArrayListFrom(1), list.NewArrayListFrom("a", "b"))
+	a.EqualNow([]Pair[int, string]{{1, "a"}}, pairs.ToSlice())
+	a.TrueNow(Zip[int, string](list.NewArrayList[int](), list.NewArrayListFrom("a")).IsEmpty())
+}
@@ -0,0 +1,122 @@
+package internal
+
+// ComputeFunc computes the new value of the specified key of a dictionary atomically. The
+// remapping function receives the current value and whether the key is present, and returns the
+// new value and whether the key should be kept, the key is removed if it returns false. It returns
+// the new value and whether the key is present after the computation. The lock-free
+// implementations may call the remapping function more than once if the value is modified
+// concurrently.
+type ComputeFunc[K comparable, V any] func(k K, remapping func(old V, loaded bool) (V, bool)) (V, bool)
+
+// PutIfAbsent associates the value with the key if the key is not present, it returns the current
+// value and true if the key is present, or returns the specified value and false.
+func PutIfAbsent[K comparable, V any](compute ComputeFunc[K, V], k K, v V) (V, bool) {
+	var actual V
+	var loaded bool
+
+	compute(k, func(old V, ok bool) (V, bool) {
+		loaded = ok
+		if ok {
+			actual = old
+		} else {
+			actual = v
+		}
+		return actual, true
+	})
+
+	return actual, loaded
+}
+
+// CompareAndSwap replaces the value of the key with the new value if the current value is equal to
+// the old value, and returns true if the value was swapped.
+func CompareAndSwap[K comparable, V any](compute ComputeFunc[K, V], k K, old, new V) bool {
+	swapped := false
+
+	compute(k, func(cur V, ok bool) (V, bool) {
+		swapped = ok && Equal(cur, old)
+		if swapped {
+			return new, true
+		}
+		return cur, ok
+	})
+
+	return swapped
+}
+
+// CompareAndDelete removes the key if its current value is equal to the old value, and returns true
+// if the key was removed.
+func CompareAndDelete[K comparable, V any](compute ComputeFunc[K, V], k K, old V) bool {
+	deleted := false
+
+	compute(k, func(cur V, ok bool) (V, bool) {
+		deleted = ok && Equal(cur, old)
+		return cur, ok && !deleted
+	})
+
+	return deleted
+}
+
+// ComputeIfAbsent associates the value computed by the mapping function with the key if the key is
+// not present, and returns the current value of the key. The mapping function is called at most
+// once even if the compute function retries the remapping function, the computed value is reused by
+// the retries.
+func ComputeIfAbsent[K comparable, V any](compute ComputeFunc[K, V], k K, mapping func(K) V) V {
+	var computed V
+	isComputed := false
+
+	v, _ := compute(k, func(cur V, ok bool) (V, bool) {
+		if ok {
+			return cur, true
+		}
+		if !isComputed {
+			computed = mapping(k)
+			isComputed = true
+		}
+		return computed, true
+	})
+
+	return v
+}
+
+// ComputeIfPresent replaces the value of the key with the value computed by the remapping function
+// if the key is present, and the key is removed if the remapping function returns false. It returns
+// the new value and whether the key is present after the computation.
+func ComputeIfPresent[K comparable, V any](
+	compute ComputeFunc[K, V],
+	k K,
+	remapping func(K, V) (V, bool),
+) (V, bool) {
+	return compute(k, func(cur V, ok bool) (V, bool) {
+		if !ok {
+			return cur, false
+		}
+		return remapping(k, cur)
+	})
+}
+
+// Compute computes the new value of the key by the remapping function, and the key is removed if
+// the remapping function returns false. It returns the new value and whether the key is present
+// after the computation.
+func Compute[K comparable, V any](
+	compute ComputeFunc[K, V],
+	k K,
+	remapping func(K, V, bool) (V, bool),
+) (V, bool) {
+	return compute(k, func(cur V, ok bool) (V, bool) {
+		return remapping(k, cur, ok)
+	})
+}
+
+// Merge associates the value with the key if the key is not present, or replaces the value of the
+// key with the result of the remapping function of the current value and the specified value. It
+// returns the new value of the key.
+func Merge[K comparable, V any](compute ComputeFunc[K, V], k K, v V, remapping func(V, V) V) V {
+	nv, _ := compute(k, func(cur V, ok bool) (V, bool) {
+		if !ok {
+			return v, true
+		}
+		return remapping(cur, v), true
+	})
+
+	return nv
+}
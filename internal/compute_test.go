@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func newTestCompute(m map[string]int) ComputeFunc[string, int] {
+	return func(k string, remapping func(int, bool) (int, bool)) (int, bool) {
+		old, ok := m[k]
+		v, keep := remapping(old, ok)
+		if !keep {
+			delete(m, k)
+			return 0, false
+		}
+		m[k] = v
+		return v, true
+	}
+}
+
+func TestPutIfAbsent(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+
+	actual, loaded := PutIfAbsent(compute, "a", 2)
+	a.TrueNow(loaded)
+	a.EqualNow(1, actual)
+	a.EqualNow(1, m["a"])
+
+	actual, loaded = PutIfAbsent(compute, "b", 2)
+	a.NotTrueNow(loaded)
+	a.EqualNow(2, actual)
+	a.EqualNow(2, m["b"])
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+
+	a.NotTrueNow(CompareAndSwap(compute, "a", 2, 3))
+	a.EqualNow(1, m["a"])
+	a.TrueNow(CompareAndSwap(compute, "a", 1, 3))
+	a.EqualNow(3, m["a"])
+
+	a.NotTrueNow(CompareAndSwap(compute, "b", 0, 1))
+	_, ok := m["b"]
+	a.NotTrueNow(ok)
+}
+
+func TestCompareAndDelete(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+
+	a.NotTrueNow(CompareAndDelete(compute, "a", 2))
+	a.EqualNow(1, len(m))
+	a.TrueNow(CompareAndDelete(compute, "a", 1))
+	a.EqualNow(0, len(m))
+	a.NotTrueNow(CompareAndDelete(compute, "a", 1))
+	a.NotTrueNow(CompareAndDelete(compute, "b", 0))
+	a.EqualNow(0, len(m))
+}
+
+func TestComputeIfAbsent(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+	calls := 0
+	mapping := func(k string) int {
+		calls++
+		return len(k)
+	}
+
+	a.EqualNow(1, ComputeIfAbsent(compute, "a", mapping))
+	a.EqualNow(0, calls)
+	a.EqualNow(3, ComputeIfAbsent(compute, "bcd", mapping))
+	a.EqualNow(1, calls)
+	a.EqualNow(3, m["bcd"])
+}
+
+func TestComputeIfAbsentRetry(t *testing.T) {
+	a := assert.New(t)
+	calls := 0
+	// the compute function retries the remapping function like a lock-free dictionary that loses
+	// the race with another writer.
+	compute := func(k string, remapping func(int, bool) (int, bool)) (int, bool) {
+		remapping(0, false)
+		return remapping(0, false)
+	}
+
+	a.EqualNow(3, ComputeIfAbsent(compute, "abc", func(k string) int {
+		calls++
+		return len(k)
+	}))
+	a.EqualNow(1, calls)
+}
+
+func TestComputeIfPresent(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1, "b": 2}
+	compute := newTestCompute(m)
+
+	v, ok := ComputeIfPresent(compute, "a", func(k string, old int) (int, bool) {
+		return old * 10, true
+	})
+	a.TrueNow(ok)
+	a.EqualNow(10, v)
+	a.EqualNow(10, m["a"])
+
+	_, ok = ComputeIfPresent(compute, "b", func(k string, old int) (int, bool) {
+		return old, false
+	})
+	a.NotTrueNow(ok)
+	_, ok = m["b"]
+	a.NotTrueNow(ok)
+
+	_, ok = ComputeIfPresent(compute, "c", func(k string, old int) (int, bool) {
+		a.TrueNow(false, "remapping should not be called for the absent key")
+		return old, true
+	})
+	a.NotTrueNow(ok)
+	_, ok = m["c"]
+	a.NotTrueNow(ok)
+}
+
+func TestCompute(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+	increase := func(k string, old int, loaded bool) (int, bool) {
+		return old + 1, true
+	}
+
+	v, ok := Compute(compute, "a", increase)
+	a.TrueNow(ok)
+	a.EqualNow(2, v)
+	v, ok = Compute(compute, "b", increase)
+	a.TrueNow(ok)
+	a.EqualNow(1, v)
+
+	_, ok = Compute(compute, "a", func(k string, old int, loaded bool) (int, bool) {
+		a.TrueNow(loaded)
+		return old, false
+	})
+	a.NotTrueNow(ok)
+	a.EqualNow(1, len(m))
+}
+
+func TestMerge(t *testing.T) {
+	a := assert.New(t)
+	m := map[string]int{"a": 1}
+	compute := newTestCompute(m)
+	sum := func(old, v int) int {
+		return old + v
+	}
+
+	a.EqualNow(3, Merge(compute, "a", 2, sum))
+	a.EqualNow(3, m["a"])
+	a.EqualNow(2, Merge(compute, "b", 2, sum))
+	a.EqualNow(2, m["b"])
+}
@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"bytes"
 	"encoding"
+	"encoding/json"
 	"fmt"
 	"strconv"
 )
@@ -56,3 +58,65 @@ func ValueString(v any) string {
 		return fmt.Sprintf("%v", v)
 	}
 }
+
+// MarshalJSONObject marshals the key-value pairs as a JSON object, and keeps the members in the
+// order of the given slices. The keys are encoded by the same rules as the builtin map.
+func MarshalJSONObject[K comparable, V any](keys []K, values []V) ([]byte, error) {
+	buf := bytes.NewBufferString("{")
+	for i, k := range keys {
+		b, err := json.Marshal(map[K]V{k: values[i]})
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.Write(b[1 : len(b)-1])
+	}
+	buf.WriteString("}")
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSONObject unmarshals a JSON object and performs the handler for each member in the
+// order they appear in the document. The keys are decoded by the same rules as the builtin map.
+func UnmarshalJSONObject[K comparable, V any](b []byte, handler func(K, V)) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		// let the builtin decoder handle the null value and report the type error
+		var tmp map[K]V
+		return json.Unmarshal(b, &tmp)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+
+		member, err := json.Marshal(map[string]json.RawMessage{key: raw})
+		if err != nil {
+			return err
+		}
+		var tmp map[K]V
+		if err := json.Unmarshal(member, &tmp); err != nil {
+			return err
+		}
+		for k, v := range tmp {
+			handler(k, v)
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
@@ -39,3 +39,44 @@ func TestValueString(t *testing.T) {
 	a.EqualNow(ValueString(strType{}), "custom string")
 	a.EqualNow(ValueString(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)), "2000-01-01 00:00:00 +0000 UTC")
 }
+
+func TestMarshalJSONObject(t *testing.T) {
+	a := assert.New(t)
+
+	b, err := MarshalJSONObject([]int{10, 2, 1}, []string{"ten", "two", "one"})
+	a.NilNow(err)
+	a.EqualNow(`{"10":"ten","2":"two","1":"one"}`, string(b))
+
+	b, err = MarshalJSONObject([]string{}, []int{})
+	a.NilNow(err)
+	a.EqualNow(`{}`, string(b))
+
+	_, err = MarshalJSONObject([]string{"a"}, []func(){func() {}})
+	a.NotNilNow(err)
+}
+
+func TestUnmarshalJSONObject(t *testing.T) {
+	a := assert.New(t)
+
+	keys := make([]int, 0)
+	values := make([]string, 0)
+	handler := func(k int, v string) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	err := UnmarshalJSONObject([]byte(`{"10":"ten","2":"two","1":"one"}`), handler)
+	a.NilNow(err)
+	a.EqualNow([]int{10, 2, 1}, keys)
+	a.EqualNow([]string{"ten", "two", "one"}, values)
+
+	keys = keys[:0]
+	err = UnmarshalJSONObject([]byte(`null`), handler)
+	a.NilNow(err)
+	a.EqualNow(0, len(keys))
+
+	a.NotNilNow(UnmarshalJSONObject([]byte(`["a","b"]`), handler))
+	a.NotNilNow(UnmarshalJSONObject([]byte(`{"a":"b"}`), handler))
+	a.NotNilNow(UnmarshalJSONObject([]byte(`{"1":1}`), handler))
+	a.NotNilNow(UnmarshalJSONObject([]byte(`{"1":"one"`), handler))
+}
@@ -0,0 +1,35 @@
+//go:build !go1.21
+
+package internal
+
+// Ordered is a constraint that permits any ordered type, it is the same as cmp.Ordered in Go 1.21
+// and later.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Compare returns -1 if x is less than y, 0 if x equals y, and +1 if x is greater than y. A NaN is
+// considered less than any non-NaN, and equal to another NaN.
+func Compare[T Ordered](x, y T) int {
+	xNaN := x != x
+	yNaN := y != y
+	if xNaN {
+		if yNaN {
+			return 0
+		}
+		return -1
+	}
+	if yNaN {
+		return +1
+	}
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return +1
+	}
+	return 0
+}
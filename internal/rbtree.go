@@ -0,0 +1,453 @@
+package internal
+
+type rbColor bool
+
+const (
+	rbRed   rbColor = false
+	rbBlack rbColor = true
+)
+
+// RBNode is a node of the red-black tree.
+type RBNode[K any, V any] struct {
+	Key   K
+	Value V
+
+	left   *RBNode[K, V]
+	right  *RBNode[K, V]
+	parent *RBNode[K, V]
+	color  rbColor
+}
+
+// Next returns the node with the smallest key greater than the key of this node, or nil if this
+// node is the last one.
+func (n *RBNode[K, V]) Next() *RBNode[K, V] {
+	if n.right != nil {
+		return rbMinimum(n.right)
+	}
+
+	p := n.parent
+	for p != nil && n == p.right {
+		n = p
+		p = p.parent
+	}
+
+	return p
+}
+
+// Prev returns the node with the greatest key less than the key of this node, or nil if this
+// node is the first one.
+func (n *RBNode[K, V]) Prev() *RBNode[K, V] {
+	if n.left != nil {
+		return rbMaximum(n.left)
+	}
+
+	p := n.parent
+	for p != nil && n == p.left {
+		n = p
+		p = p.parent
+	}
+
+	return p
+}
+
+// RBTree is a red-black tree that keeps its nodes sorted by the comparator.
+type RBTree[K any, V any] struct {
+	root *RBNode[K, V]
+	size int
+	cmp  func(K, K) int
+}
+
+// NewRBTree creates a new empty red-black tree with the specified comparator. The comparator
+// returns a negative number if a < b, zero if a == b, and a positive number if a > b.
+func NewRBTree[K any, V any](cmp func(K, K) int) *RBTree[K, V] {
+	t := new(RBTree[K, V])
+	t.cmp = cmp
+
+	return t
+}
+
+// Clear removes all nodes from the tree.
+func (t *RBTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Clone returns a copy of the tree with the same comparator.
+func (t *RBTree[K, V]) Clone() *RBTree[K, V] {
+	clone := NewRBTree[K, V](t.cmp)
+	clone.root = rbCloneNode(t.root, nil)
+	clone.size = t.size
+
+	return clone
+}
+
+// Comparator returns the comparator of the tree.
+func (t *RBTree[K, V]) Comparator() func(K, K) int {
+	return t.cmp
+}
+
+// Size returns the number of nodes in the tree.
+func (t *RBTree[K, V]) Size() int {
+	return t.size
+}
+
+// First returns the node with the smallest key, or nil if the tree is empty.
+func (t *RBTree[K, V]) First() *RBNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return rbMinimum(t.root)
+}
+
+// Last returns the node with the greatest key, or nil if the tree is empty.
+func (t *RBTree[K, V]) Last() *RBNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return rbMaximum(t.root)
+}
+
+// Find returns the node with the specified key, or nil if no such node.
+func (t *RBTree[K, V]) Find(k K) *RBNode[K, V] {
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(k, cur.Key)
+		switch {
+		case c < 0:
+			cur = cur.left
+		case c > 0:
+			cur = cur.right
+		default:
+			return cur
+		}
+	}
+
+	return nil
+}
+
+// Floor returns the node with the greatest key less than or equal to the specified key, or nil if
+// no such node.
+func (t *RBTree[K, V]) Floor(k K) *RBNode[K, V] {
+	var res *RBNode[K, V]
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(k, cur.Key)
+		switch {
+		case c < 0:
+			cur = cur.left
+		case c > 0:
+			res = cur
+			cur = cur.right
+		default:
+			return cur
+		}
+	}
+
+	return res
+}
+
+// Ceiling returns the node with the smallest key greater than or equal to the specified key, or
+// nil if no such node.
+func (t *RBTree[K, V]) Ceiling(k K) *RBNode[K, V] {
+	var res *RBNode[K, V]
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(k, cur.Key)
+		switch {
+		case c < 0:
+			res = cur
+			cur = cur.left
+		case c > 0:
+			cur = cur.right
+		default:
+			return cur
+		}
+	}
+
+	return res
+}
+
+// Lower returns the node with the greatest key strictly less than the specified key, or nil if no
+// such node.
+func (t *RBTree[K, V]) Lower(k K) *RBNode[K, V] {
+	var res *RBNode[K, V]
+	cur := t.root
+	for cur != nil {
+		if t.cmp(k, cur.Key) <= 0 {
+			cur = cur.left
+		} else {
+			res = cur
+			cur = cur.right
+		}
+	}
+
+	return res
+}
+
+// Higher returns the node with the smallest key strictly greater than the specified key, or nil
+// if no such node.
+func (t *RBTree[K, V]) Higher(k K) *RBNode[K, V] {
+	var res *RBNode[K, V]
+	cur := t.root
+	for cur != nil {
+		if t.cmp(k, cur.Key) < 0 {
+			res = cur
+			cur = cur.left
+		} else {
+			cur = cur.right
+		}
+	}
+
+	return res
+}
+
+// Insert inserts a new node with the specified key and value into the tree. If the tree already
+// contains a node with the key, it returns the existing node and false without modifying it.
+func (t *RBTree[K, V]) Insert(k K, v V) (*RBNode[K, V], bool) {
+	var parent *RBNode[K, V]
+	c := 0
+	cur := t.root
+	for cur != nil {
+		parent = cur
+		c = t.cmp(k, cur.Key)
+		switch {
+		case c < 0:
+			cur = cur.left
+		case c > 0:
+			cur = cur.right
+		default:
+			return cur, false
+		}
+	}
+
+	n := &RBNode[K, V]{Key: k, Value: v, parent: parent, color: rbRed}
+	if parent == nil {
+		t.root = n
+	} else if c < 0 {
+		parent.left = n
+	} else {
+		parent.right = n
+	}
+	t.size++
+	t.insertFixup(n)
+
+	return n, true
+}
+
+// Delete removes the specified node from the tree. The node must belong to this tree.
+func (t *RBTree[K, V]) Delete(z *RBNode[K, V]) {
+	var x, xParent *RBNode[K, V]
+	y := z
+	yColor := y.color
+
+	switch {
+	case z.left == nil:
+		x = z.right
+		xParent = z.parent
+		t.transplant(z, z.right)
+	case z.right == nil:
+		x = z.left
+		xParent = z.parent
+		t.transplant(z, z.left)
+	default:
+		y = rbMinimum(z.right)
+		yColor = y.color
+		x = y.right
+		if y.parent == z {
+			xParent = y
+		} else {
+			xParent = y.parent
+			t.transplant(y, y.right)
+			y.right = z.right
+			y.right.parent = y
+		}
+		t.transplant(z, y)
+		y.left = z.left
+		y.left.parent = y
+		y.color = z.color
+	}
+
+	z.left = nil
+	z.right = nil
+	z.parent = nil
+	t.size--
+
+	if yColor == rbBlack {
+		t.deleteFixup(x, xParent)
+	}
+}
+
+func (t *RBTree[K, V]) insertFixup(z *RBNode[K, V]) {
+	for z.parent != nil && z.parent.color == rbRed {
+		gp := z.parent.parent
+		if z.parent == gp.left {
+			y := gp.right
+			if rbColorOf(y) == rbRed {
+				z.parent.color = rbBlack
+				y.color = rbBlack
+				gp.color = rbRed
+				z = gp
+				continue
+			}
+			if z == z.parent.right {
+				z = z.parent
+				t.rotateLeft(z)
+			}
+			z.parent.color = rbBlack
+			gp.color = rbRed
+			t.rotateRight(gp)
+		} else {
+			y := gp.left
+			if rbColorOf(y) == rbRed {
+				z.parent.color = rbBlack
+				y.color = rbBlack
+				gp.color = rbRed
+				z = gp
+				continue
+			}
+			if z == z.parent.left {
+				z = z.parent
+				t.rotateRight(z)
+			}
+			z.parent.color = rbBlack
+			gp.color = rbRed
+			t.rotateLeft(gp)
+		}
+	}
+	t.root.color = rbBlack
+}
+
+func (t *RBTree[K, V]) deleteFixup(x, parent *RBNode[K, V]) {
+	for x != t.root && rbColorOf(x) == rbBlack {
+		if x == parent.left {
+			w := parent.right
+			if rbColorOf(w) == rbRed {
+				w.color = rbBlack
+				parent.color = rbRed
+				t.rotateLeft(parent)
+				w = parent.right
+			}
+			if rbColorOf(w.left) == rbBlack && rbColorOf(w.right) == rbBlack {
+				w.color = rbRed
+				x = parent
+				parent = x.parent
+				continue
+			}
+			if rbColorOf(w.right) == rbBlack {
+				w.left.color = rbBlack
+				w.color = rbRed
+				t.rotateRight(w)
+				w = parent.right
+			}
+			w.color = parent.color
+			parent.color = rbBlack
+			w.right.color = rbBlack
+			t.rotateLeft(parent)
+			x = t.root
+		} else {
+			w := parent.left
+			if rbColorOf(w) == rbRed {
+				w.color = rbBlack
+				parent.color = rbRed
+				t.rotateRight(parent)
+				w = parent.left
+			}
+			if rbColorOf(w.left) == rbBlack && rbColorOf(w.right) == rbBlack {
+				w.color = rbRed
+				x = parent
+				parent = x.parent
+				continue
+			}
+			if rbColorOf(w.left) == rbBlack {
+				w.right.color = rbBlack
+				w.color = rbRed
+				t.rotateLeft(w)
+				w = parent.left
+			}
+			w.color = parent.color
+			parent.color = rbBlack
+			w.left.color = rbBlack
+			t.rotateRight(parent)
+			x = t.root
+		}
+	}
+	if x != nil {
+		x.color = rbBlack
+	}
+}
+
+func (t *RBTree[K, V]) rotateLeft(x *RBNode[K, V]) {
+	y := x.right
+	x.right = y.left
+	if y.left != nil {
+		y.left.parent = x
+	}
+	y.parent = x.parent
+	t.replaceChild(x, y)
+	y.left = x
+	x.parent = y
+}
+
+func (t *RBTree[K, V]) rotateRight(x *RBNode[K, V]) {
+	y := x.left
+	x.left = y.right
+	if y.right != nil {
+		y.right.parent = x
+	}
+	y.parent = x.parent
+	t.replaceChild(x, y)
+	y.right = x
+	x.parent = y
+}
+
+// replaceChild makes v take the place of u in u's parent, without touching v's parent pointer.
+func (t *RBTree[K, V]) replaceChild(u, v *RBNode[K, V]) {
+	switch {
+	case u.parent == nil:
+		t.root = v
+	case u == u.parent.left:
+		u.parent.left = v
+	default:
+		u.parent.right = v
+	}
+}
+
+func (t *RBTree[K, V]) transplant(u, v *RBNode[K, V]) {
+	t.replaceChild(u, v)
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+func rbColorOf[K any, V any](n *RBNode[K, V]) rbColor {
+	if n == nil {
+		return rbBlack
+	}
+	return n.color
+}
+
+func rbMinimum[K any, V any](n *RBNode[K, V]) *RBNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func rbMaximum[K any, V any](n *RBNode[K, V]) *RBNode[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func rbCloneNode[K any, V any](n, parent *RBNode[K, V]) *RBNode[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := &RBNode[K, V]{Key: n.Key, Value: n.Value, parent: parent, color: n.color}
+	c.left = rbCloneNode(n.left, c)
+	c.right = rbCloneNode(n.right, c)
+
+	return c
+}
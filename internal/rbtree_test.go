@@ -0,0 +1,141 @@
+package internal
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func intComparator(a, b int) int {
+	return a - b
+}
+
+// checkRBTree verifies the red-black properties of the tree, and returns the keys in order.
+func checkRBTree(a *assert.Assertion, t *RBTree[int, int]) []int {
+	a.TrueNow(rbColorOf(t.root) == rbBlack)
+	if t.root != nil {
+		a.NilNow(t.root.parent)
+	}
+
+	var walk func(n *RBNode[int, int]) int
+	walk = func(n *RBNode[int, int]) int {
+		if n == nil {
+			return 1
+		}
+		if n.left != nil {
+			a.TrueNow(n.left.parent == n)
+			a.TrueNow(n.left.Key < n.Key)
+		}
+		if n.right != nil {
+			a.TrueNow(n.right.parent == n)
+			a.TrueNow(n.right.Key > n.Key)
+		}
+		if n.color == rbRed {
+			a.TrueNow(rbColorOf(n.left) == rbBlack)
+			a.TrueNow(rbColorOf(n.right) == rbBlack)
+		}
+		lh := walk(n.left)
+		rh := walk(n.right)
+		a.EqualNow(lh, rh)
+		if n.color == rbBlack {
+			return lh + 1
+		}
+		return lh
+	}
+	walk(t.root)
+
+	keys := make([]int, 0, t.Size())
+	for n := t.First(); n != nil; n = n.Next() {
+		keys = append(keys, n.Key)
+	}
+	a.EqualNow(t.Size(), len(keys))
+
+	return keys
+}
+
+func TestRBTreeInsertAndDelete(t *testing.T) {
+	a := assert.New(t)
+	tree := NewRBTree[int, int](intComparator)
+	expected := make(map[int]bool)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		k := r.Intn(500)
+		_, inserted := tree.Insert(k, k*10)
+		a.EqualNow(!expected[k], inserted)
+		expected[k] = true
+	}
+	keys := checkRBTree(a, tree)
+	a.EqualNow(len(expected), len(keys))
+	for i := 1; i < len(keys); i++ {
+		a.TrueNow(keys[i-1] < keys[i])
+	}
+
+	for i := 0; i < 1000; i++ {
+		k := r.Intn(500)
+		n := tree.Find(k)
+		a.EqualNow(expected[k], n != nil)
+		if n != nil {
+			a.EqualNow(k*10, n.Value)
+			tree.Delete(n)
+			delete(expected, k)
+		}
+		if i%100 == 0 {
+			checkRBTree(a, tree)
+		}
+	}
+	a.EqualNow(len(expected), len(checkRBTree(a, tree)))
+
+	for n := tree.First(); n != nil; n = tree.First() {
+		tree.Delete(n)
+	}
+	a.EqualNow(0, tree.Size())
+	a.NilNow(tree.First())
+	a.NilNow(tree.Last())
+}
+
+func TestRBTreeNavigation(t *testing.T) {
+	a := assert.New(t)
+	tree := NewRBTree[int, int](intComparator)
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		tree.Insert(k, k)
+	}
+
+	a.EqualNow(10, tree.First().Key)
+	a.EqualNow(50, tree.Last().Key)
+	a.EqualNow(40, tree.Last().Prev().Key)
+	a.NilNow(tree.First().Prev())
+	a.NilNow(tree.Last().Next())
+
+	a.EqualNow(20, tree.Floor(25).Key)
+	a.EqualNow(20, tree.Floor(20).Key)
+	a.NilNow(tree.Floor(5))
+	a.EqualNow(30, tree.Ceiling(25).Key)
+	a.EqualNow(30, tree.Ceiling(30).Key)
+	a.NilNow(tree.Ceiling(55))
+	a.EqualNow(20, tree.Lower(30).Key)
+	a.NilNow(tree.Lower(10))
+	a.EqualNow(40, tree.Higher(30).Key)
+	a.NilNow(tree.Higher(50))
+}
+
+func TestRBTreeClone(t *testing.T) {
+	a := assert.New(t)
+	tree := NewRBTree[int, int](intComparator)
+	for i := 0; i < 100; i++ {
+		tree.Insert(i, i)
+	}
+
+	clone := tree.Clone()
+	a.EqualNow(checkRBTree(a, tree), checkRBTree(a, clone))
+
+	clone.Delete(clone.Find(50))
+	a.NotNilNow(tree.Find(50))
+	a.EqualNow(100, tree.Size())
+	a.EqualNow(99, clone.Size())
+
+	tree.Clear()
+	a.EqualNow(0, tree.Size())
+	a.EqualNow(99, clone.Size())
+}
@@ -0,0 +1,200 @@
+package internal
+
+import "github.com/ghosind/collection"
+
+// RBView is a view of the nodes of a red-black tree whose keys are in a range, the lower bound is
+// inclusive and the upper bound is exclusive, and either bound can be absent. The views share the
+// tree, so the changes made through a view are visible in the tree and all other views.
+type RBView[K any, V any] struct {
+	tree    *RBTree[K, V]
+	from    K
+	to      K
+	hasFrom bool
+	hasTo   bool
+}
+
+// NewRBView creates a new view of the whole tree.
+func NewRBView[K any, V any](tree *RBTree[K, V]) *RBView[K, V] {
+	v := new(RBView[K, V])
+	v.tree = tree
+
+	return v
+}
+
+// Ceiling returns the node in the view with the least key greater than or equal to the specified
+// key, or nil if no such node.
+func (v *RBView[K, V]) Ceiling(k K) *RBNode[K, V] {
+	if v.belowFrom(k) {
+		return v.First()
+	}
+	return v.clip(v.tree.Ceiling(k))
+}
+
+// Clear removes all nodes in the view from the tree.
+func (v *RBView[K, V]) Clear() {
+	if !v.IsBounded() {
+		v.tree.Clear()
+		return
+	}
+
+	for n := v.First(); n != nil; {
+		next := v.Next(n)
+		v.tree.Delete(n)
+		n = next
+	}
+}
+
+// Clone returns a new tree that contains the copies of the nodes in the view.
+func (v *RBView[K, V]) Clone() *RBTree[K, V] {
+	if !v.IsBounded() {
+		return v.tree.Clone()
+	}
+
+	tree := NewRBTree[K, V](v.tree.cmp)
+	for n := v.First(); n != nil; n = v.Next(n) {
+		tree.Insert(n.Key, n.Value)
+	}
+
+	return tree
+}
+
+// Comparator returns the comparator of the tree.
+func (v *RBView[K, V]) Comparator() func(K, K) int {
+	return v.tree.cmp
+}
+
+// Delete removes the specified node from the tree. The node must belong to the view.
+func (v *RBView[K, V]) Delete(n *RBNode[K, V]) {
+	v.tree.Delete(n)
+}
+
+// Find returns the node in the view with the specified key, or nil if no such node.
+func (v *RBView[K, V]) Find(k K) *RBNode[K, V] {
+	if !v.InRange(k) {
+		return nil
+	}
+	return v.tree.Find(k)
+}
+
+// First returns the node in the view with the smallest key, or nil if the view is empty.
+func (v *RBView[K, V]) First() *RBNode[K, V] {
+	if !v.hasFrom {
+		return v.clip(v.tree.First())
+	}
+	return v.clip(v.tree.Ceiling(v.from))
+}
+
+// Floor returns the node in the view with the greatest key less than or equal to the specified key,
+// or nil if no such node.
+func (v *RBView[K, V]) Floor(k K) *RBNode[K, V] {
+	if v.notBelowTo(k) {
+		return v.Last()
+	}
+	return v.clip(v.tree.Floor(k))
+}
+
+// Higher returns the node in the view with the least key strictly greater than the specified key,
+// or nil if no such node.
+func (v *RBView[K, V]) Higher(k K) *RBNode[K, V] {
+	if v.belowFrom(k) {
+		return v.First()
+	}
+	return v.clip(v.tree.Higher(k))
+}
+
+// InRange returns true if the specified key is in the range of the view.
+func (v *RBView[K, V]) InRange(k K) bool {
+	return !v.belowFrom(k) && !v.notBelowTo(k)
+}
+
+// Insert inserts a new node with the specified key and value into the tree like RBTree.Insert, it
+// panics with ErrKeyOutOfRange if the key is not in the range of the view.
+func (v *RBView[K, V]) Insert(k K, val V) (*RBNode[K, V], bool) {
+	if !v.InRange(k) {
+		panic(collection.ErrKeyOutOfRange)
+	}
+	return v.tree.Insert(k, val)
+}
+
+// IsBounded returns true if the view has any bound.
+func (v *RBView[K, V]) IsBounded() bool {
+	return v.hasFrom || v.hasTo
+}
+
+// Last returns the node in the view with the greatest key, or nil if the view is empty.
+func (v *RBView[K, V]) Last() *RBNode[K, V] {
+	if !v.hasTo {
+		return v.clip(v.tree.Last())
+	}
+	return v.clip(v.tree.Lower(v.to))
+}
+
+// Lower returns the node in the view with the greatest key strictly less than the specified key, or
+// nil if no such node.
+func (v *RBView[K, V]) Lower(k K) *RBNode[K, V] {
+	if v.notBelowTo(k) {
+		return v.Last()
+	}
+	return v.clip(v.tree.Lower(k))
+}
+
+// Next returns the node after the specified node in the view, or nil if the node is the last one.
+func (v *RBView[K, V]) Next(n *RBNode[K, V]) *RBNode[K, V] {
+	return v.clip(n.Next())
+}
+
+// Prev returns the node before the specified node in the view, or nil if the node is the first
+// one.
+func (v *RBView[K, V]) Prev(n *RBNode[K, V]) *RBNode[K, V] {
+	return v.clip(n.Prev())
+}
+
+// Size returns the number of nodes in the view, it takes linear time if the view is bounded.
+func (v *RBView[K, V]) Size() int {
+	if !v.IsBounded() {
+		return v.tree.Size()
+	}
+
+	size := 0
+	for n := v.First(); n != nil; n = v.Next(n) {
+		size++
+	}
+
+	return size
+}
+
+// Sub returns a view of the same tree whose range is the intersection of the range of this view and
+// the specified bounds.
+func (v *RBView[K, V]) Sub(from K, hasFrom bool, to K, hasTo bool) *RBView[K, V] {
+	sub := new(RBView[K, V])
+	*sub = *v
+
+	if hasFrom && (!sub.hasFrom || v.tree.cmp(from, sub.from) > 0) {
+		sub.from = from
+		sub.hasFrom = true
+	}
+	if hasTo && (!sub.hasTo || v.tree.cmp(to, sub.to) < 0) {
+		sub.to = to
+		sub.hasTo = true
+	}
+
+	return sub
+}
+
+// belowFrom returns true if the key is less than the lower bound.
+func (v *RBView[K, V]) belowFrom(k K) bool {
+	return v.hasFrom && v.tree.cmp(k, v.from) < 0
+}
+
+// clip returns the node if it is in the range of the view, or nil otherwise.
+func (v *RBView[K, V]) clip(n *RBNode[K, V]) *RBNode[K, V] {
+	if n == nil || !v.InRange(n.Key) {
+		return nil
+	}
+	return n
+}
+
+// notBelowTo returns true if the key is greater than or equal to the upper bound.
+func (v *RBView[K, V]) notBelowTo(k K) bool {
+	return v.hasTo && v.tree.cmp(k, v.to) >= 0
+}
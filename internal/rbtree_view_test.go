@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func rbViewKeys(v *RBView[int, int]) []int {
+	keys := []int{}
+	for n := v.First(); n != nil; n = v.Next(n) {
+		keys = append(keys, n.Key)
+	}
+	return keys
+}
+
+func TestRBView(t *testing.T) {
+	a := assert.New(t)
+	tree := NewRBTree[int, int](intComparator)
+	for _, k := range []int{30, 10, 50, 20, 40} {
+		tree.Insert(k, k)
+	}
+
+	all := NewRBView(tree)
+	a.NotTrueNow(all.IsBounded())
+	a.EqualNow([]int{10, 20, 30, 40, 50}, rbViewKeys(all))
+
+	v := all.Sub(15, true, 0, false).Sub(0, true, 45, true)
+	a.TrueNow(v.IsBounded())
+	a.EqualNow([]int{20, 30, 40}, rbViewKeys(v))
+	a.EqualNow(3, v.Size())
+	a.EqualNow(20, v.First().Key)
+	a.EqualNow(40, v.Last().Key)
+	a.NilNow(v.Prev(v.First()))
+	a.NilNow(v.Find(10))
+	a.EqualNow(30, v.Find(30).Key)
+
+	a.EqualNow(20, v.Ceiling(0).Key)
+	a.EqualNow(20, v.Higher(10).Key)
+	a.NilNow(v.Ceiling(45))
+	a.EqualNow(40, v.Floor(100).Key)
+	a.EqualNow(40, v.Lower(50).Key)
+	a.NilNow(v.Floor(15))
+
+	v.Insert(25, 25)
+	a.EqualNow(25, tree.Find(25).Value)
+	a.PanicOfNow(func() {
+		v.Insert(45, 45)
+	}, collection.ErrKeyOutOfRange)
+
+	clone := v.Clone()
+	a.EqualNow([]int{20, 25, 30, 40}, checkRBTree(a, clone))
+
+	v.Clear()
+	a.EqualNow(0, v.Size())
+	a.EqualNow([]int{10, 50}, checkRBTree(a, tree))
+
+	empty := all.Sub(30, true, 20, true)
+	a.NilNow(empty.First())
+	a.NilNow(empty.Last())
+}
@@ -51,6 +51,35 @@ func (e *SyncEntry[T]) Delete() (*T, bool) {
 	}
 }
 
+func (e *SyncEntry[T]) TryCompute(fn func(T, bool) (T, bool)) (value T, present bool, ok bool) {
+	for {
+		p := e.p.Load()
+		if p == e.expunged {
+			return value, false, false
+		}
+
+		var old T
+		if p != nil {
+			old = *p
+		}
+		v, keep := fn(old, p != nil)
+		if !keep && p == nil {
+			return value, false, true
+		}
+
+		var np *T
+		if keep {
+			np = &v
+		}
+		if e.p.CompareAndSwap(p, np) {
+			if keep {
+				value = v
+			}
+			return value, keep, true
+		}
+	}
+}
+
 func (e *SyncEntry[T]) UnexpungeLocked() bool {
 	return e.p.CompareAndSwap(e.expunged, nil)
 }
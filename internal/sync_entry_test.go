@@ -193,6 +193,84 @@ func TestSyncEntryDeleteConcurrent(t *testing.T) {
 	a.EqualNow(1, successCount)
 }
 
+func TestSyncEntryTryCompute(t *testing.T) {
+	a := assert.New(t)
+	expunged := new(int)
+	entry := NewSyncEntry(42, expunged)
+
+	// Test computing a new value from the present value
+	val, present, ok := entry.TryCompute(func(v int, loaded bool) (int, bool) {
+		a.TrueNow(loaded)
+		a.EqualNow(42, v)
+		return v + 1, true
+	})
+	a.TrueNow(ok)
+	a.TrueNow(present)
+	a.EqualNow(43, val)
+
+	// Test deleting the value
+	val, present, ok = entry.TryCompute(func(v int, loaded bool) (int, bool) {
+		return v, false
+	})
+	a.TrueNow(ok)
+	a.NotTrueNow(present)
+	a.EqualNow(0, val)
+	a.NilNow(entry.p.Load())
+
+	// Test the deleted entry keeps nil if the function returns false
+	_, present, ok = entry.TryCompute(func(v int, loaded bool) (int, bool) {
+		a.NotTrueNow(loaded)
+		return 1, false
+	})
+	a.TrueNow(ok)
+	a.NotTrueNow(present)
+	a.NilNow(entry.p.Load())
+
+	// Test storing a value into the deleted entry
+	val, present, ok = entry.TryCompute(func(v int, loaded bool) (int, bool) {
+		return 10, true
+	})
+	a.TrueNow(ok)
+	a.TrueNow(present)
+	a.EqualNow(10, val)
+
+	// Test computing on the expunged entry
+	entry.p.Store(expunged)
+	_, _, ok = entry.TryCompute(func(v int, loaded bool) (int, bool) {
+		a.TrueNow(false, "function should not be called on the expunged entry")
+		return v, true
+	})
+	a.NotTrueNow(ok)
+}
+
+func TestSyncEntryTryComputeConcurrent(t *testing.T) {
+	a := assert.New(t)
+	expunged := new(int)
+	entry := NewSyncEntry(0, expunged)
+
+	const numGoroutines = 10
+	const numIncrements = 100
+	var wg sync.WaitGroup
+
+	wg.Add(numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 0; j < numIncrements; j++ {
+				entry.TryCompute(func(v int, loaded bool) (int, bool) {
+					return v + 1, true
+				})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	val, ok := entry.Load(0)
+	a.TrueNow(ok)
+	a.EqualNow(numGoroutines*numIncrements, val)
+}
+
 func TestSyncEntryUnexpungeLocked(t *testing.T) {
 	a := assert.New(t)
 	expunged := new(int)
@@ -20,3 +20,23 @@ type DictIter[K comparable, V any] interface {
 	// ValuesIter returns an iterator over the values in the dictionary.
 	ValuesIter() iter.Seq[V]
 }
+
+type SortedDictIter[K comparable, V any] interface {
+	// DescendingIter returns an iterator of all key-value pairs in descending key order.
+	DescendingIter() iter.Seq2[K, V]
+}
+
+type SortedSetIter[T any] interface {
+	// DescendingIter returns an iterator of all elements in descending order.
+	DescendingIter() iter.Seq[T]
+}
+
+type DequeIter[T any] interface {
+	// DescendingIter returns an iterator of all elements in reverse sequential order.
+	DescendingIter() iter.Seq[T]
+}
+
+type MultiSetIter[T comparable] interface {
+	// EntryIter returns an iterator of all distinct elements and their counts.
+	EntryIter() iter.Seq2[T, int]
+}
@@ -16,3 +16,19 @@ type DictIter[K comparable, V any] interface {
 	// ValuesIter returns a channel over the values in the dictionary.
 	ValuesIter() <-chan V
 }
+
+type SortedDictIter[K comparable, V any] interface {
+}
+
+type SortedSetIter[T any] interface {
+	// DescendingIter returns a channel of all elements in descending order.
+	DescendingIter() <-chan T
+}
+
+type DequeIter[T any] interface {
+	// DescendingIter returns a channel of all elements in reverse sequential order.
+	DescendingIter() <-chan T
+}
+
+type MultiSetIter[T comparable] interface {
+}
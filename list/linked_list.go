@@ -256,6 +256,71 @@ func (l *LinkedList[T]) LastIndexOf(e T) int {
 	return -1
 }
 
+// Offer inserts the specified element at the end of this list.
+func (l *LinkedList[T]) Offer(e T) bool {
+	return l.Add(e)
+}
+
+// OfferFirst inserts the specified element at the front of this list.
+func (l *LinkedList[T]) OfferFirst(e T) bool {
+	l.AddAtIndex(0, e)
+	return true
+}
+
+// OfferLast inserts the specified element at the end of this list.
+func (l *LinkedList[T]) OfferLast(e T) bool {
+	return l.Add(e)
+}
+
+// Peek returns the first element of this list without removing it, or false if this list is
+// empty.
+func (l *LinkedList[T]) Peek() (T, bool) {
+	return l.PeekFirst()
+}
+
+// PeekFirst returns the first element of this list without removing it, or false if this list is
+// empty.
+func (l *LinkedList[T]) PeekFirst() (T, bool) {
+	if l.head == nil {
+		return *new(T), false
+	}
+	return l.head.Value, true
+}
+
+// PeekLast returns the last element of this list without removing it, or false if this list is
+// empty.
+func (l *LinkedList[T]) PeekLast() (T, bool) {
+	if l.tail == nil {
+		return *new(T), false
+	}
+	return l.tail.Value, true
+}
+
+// Poll removes and returns the first element of this list, or false if this list is empty.
+func (l *LinkedList[T]) Poll() (T, bool) {
+	return l.PollFirst()
+}
+
+// PollFirst removes and returns the first element of this list, or false if this list is empty.
+func (l *LinkedList[T]) PollFirst() (T, bool) {
+	if l.head == nil {
+		return *new(T), false
+	}
+	val := l.head.Value
+	l.removeNode(l.head)
+	return val, true
+}
+
+// PollLast removes and returns the last element of this list, or false if this list is empty.
+func (l *LinkedList[T]) PollLast() (T, bool) {
+	if l.tail == nil {
+		return *new(T), false
+	}
+	val := l.tail.Value
+	l.removeNode(l.tail)
+	return val, true
+}
+
 // Remove removes the specified element from this collection.
 func (l *LinkedList[T]) Remove(e T) bool {
 	if l.size == 0 {
@@ -14,3 +14,14 @@ func (l *LinkedList[T]) Iter() iter.Seq[T] {
 		}
 	}
 }
+
+// DescendingIter returns an iterator of all elements in this list in reverse order.
+func (l *LinkedList[T]) DescendingIter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.tail; node != nil; node = node.Prev {
+			if !yield(node.Value) {
+				break
+			}
+		}
+	}
+}
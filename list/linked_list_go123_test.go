@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package list
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestLinkedListDescendingIter(t *testing.T) {
+	a := assert.New(t)
+	l := NewLinkedListFrom(1, 2, 3)
+
+	res := make([]int, 0, l.Size())
+	for v := range l.DescendingIter() {
+		res = append(res, v)
+	}
+	a.EqualNow([]int{3, 2, 1}, res)
+
+	for range l.DescendingIter() {
+		// yield should returns false
+		break
+	}
+}
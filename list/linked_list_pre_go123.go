@@ -13,3 +13,15 @@ func (l *LinkedList[T]) Iter() <-chan T {
 	}()
 	return ch
 }
+
+// DescendingIter returns a channel of all elements in this list in reverse order.
+func (l *LinkedList[T]) DescendingIter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for node := l.tail; node != nil; node = node.Prev {
+			ch <- node.Value
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -30,3 +30,37 @@ func TestLockLinkedList(t *testing.T) {
 
 	testList(a, constructor)
 }
+
+func TestLinkedListDeque(t *testing.T) {
+	a := assert.New(t)
+	var d collection.Deque[int] = NewLinkedList[int]()
+
+	_, ok := d.Peek()
+	a.NotTrueNow(ok)
+	_, ok = d.PeekLast()
+	a.NotTrueNow(ok)
+	_, ok = d.Poll()
+	a.NotTrueNow(ok)
+	_, ok = d.PollLast()
+	a.NotTrueNow(ok)
+
+	a.TrueNow(d.Offer(2))
+	a.TrueNow(d.OfferFirst(1))
+	a.TrueNow(d.OfferLast(3))
+	a.EqualNow([]int{1, 2, 3}, d.ToSlice())
+
+	e, ok := d.PeekFirst()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	e, ok = d.PeekLast()
+	a.TrueNow(ok)
+	a.EqualNow(3, e)
+
+	e, ok = d.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(3, e)
+	e, ok = d.Poll()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	a.EqualNow([]int{2}, d.ToSlice())
+}
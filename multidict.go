@@ -0,0 +1,61 @@
+package collection
+
+// MultiDict is a object that maps keys to values like Dict, but a key may be associated with
+// multiple values. The Size method of a MultiDict returns the total number of key-value pairs.
+type MultiDict[K comparable, V any] interface {
+	Iterable2[K, V]
+	Stringer
+	JSONMarshaler
+	JSONUnmarshaler
+
+	// Clear removes all key-value pairs in this dictionary.
+	Clear()
+
+	// Clone returns a copy of this dictionary.
+	Clone() MultiDict[K, V]
+
+	// ContainsEntry returns true if this dictionary contains the specified key-value pair.
+	ContainsEntry(k K, v V) bool
+
+	// ContainsKey returns true if this dictionary contains at least one value with the specified
+	// key.
+	ContainsKey(k K) bool
+
+	// Equals compares this dictionary with the object pass from parameter.
+	Equals(o any) bool
+
+	// ForEach performs the given handler for each key-value pairs in the dictionary until all pairs
+	// have been processed or the handler returns an error.
+	ForEach(handler func(k K, v V) error) error
+
+	// GetAll returns a slice of the values associated with the specified key.
+	GetAll(k K) []V
+
+	// IsEmpty returns true if this dictionary is empty.
+	IsEmpty() bool
+
+	// Keys returns a slice that contains all the distinct keys in this dictionary.
+	Keys() []K
+
+	// Put associates the specified value with the specified key in addition to the existing values,
+	// and returns true if this dictionary changed.
+	Put(k K, v V) bool
+
+	// PutAll associates all of the specified values with the specified key in addition to the
+	// existing values, and returns true if this dictionary changed.
+	PutAll(k K, values ...V) bool
+
+	// RemoveAll removes all values associated with the specified key, and returns the removed
+	// values.
+	RemoveAll(k K) []V
+
+	// RemoveValue removes the specified value associated with the specified key, and returns true
+	// if this dictionary changed.
+	RemoveValue(k K, v V) bool
+
+	// Size returns the number of key-value pairs in this dictionary.
+	Size() int
+
+	// Values returns a slice that contains the values of all key-value pairs in this dictionary.
+	Values() []V
+}
@@ -0,0 +1,44 @@
+package collection
+
+// MultiSetEntry is an element of the MultiSet with its number of occurrences.
+type MultiSetEntry[T any] struct {
+	// Element is the element of the entry.
+	Element T
+	// Count is the number of occurrences of the element.
+	Count int
+}
+
+// MultiSet is a collection that supports order-independent equality like Set, but may have
+// duplicate elements. It is also known as bag. The Size method of a MultiSet returns the total
+// number of occurrences of all elements.
+type MultiSet[T comparable] interface {
+	Collection[T]
+	MultiSetIter[T]
+
+	// AddN adds n occurrences of the specified element to this multiset, and returns the number of
+	// occurrences of the element before the operation. It does nothing if n is not positive.
+	AddN(e T, n int) int
+
+	// Clone returns a copy of this multiset.
+	Clone() MultiSet[T]
+
+	// Count returns the number of occurrences of the specified element in this multiset.
+	Count(e T) int
+
+	// ElementSet returns a set of the distinct elements in this multiset.
+	ElementSet() Set[T]
+
+	// MostCommon returns the n most common elements and their counts in descending order of the
+	// counts. All elements are returned if n is not positive or greater than the number of distinct
+	// elements.
+	MostCommon(n int) []MultiSetEntry[T]
+
+	// RemoveN removes n occurrences of the specified element from this multiset, and returns the
+	// number of occurrences of the element before the operation. All occurrences are removed if
+	// there are fewer than n occurrences.
+	RemoveN(e T, n int) int
+
+	// SetCount sets the number of occurrences of the specified element, and returns the number of
+	// occurrences of the element before the operation. The element is removed if n is not positive.
+	SetCount(e T, n int) int
+}
@@ -0,0 +1,303 @@
+package multiset
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+	"github.com/ghosind/collection/set"
+)
+
+// HashMultiSet is a multiset implementation that uses a Golang builtin map to store the elements
+// and their counts.
+type HashMultiSet[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// NewHashMultiSet creates a new HashMultiSet.
+func NewHashMultiSet[T comparable]() *HashMultiSet[T] {
+	ms := new(HashMultiSet[T])
+	ms.counts = make(map[T]int)
+
+	return ms
+}
+
+// NewHashMultiSetFrom creates and returns a new HashMultiSet containing the elements of the
+// provided collection.
+func NewHashMultiSetFrom[T comparable](c ...T) *HashMultiSet[T] {
+	ms := NewHashMultiSet[T]()
+	ms.AddAll(c...)
+
+	return ms
+}
+
+// Add adds an occurrence of the specified element to this multiset.
+func (ms *HashMultiSet[T]) Add(e T) bool {
+	ms.AddN(e, 1)
+
+	return true
+}
+
+// AddAll adds an occurrence of each of the specified elements to this multiset.
+func (ms *HashMultiSet[T]) AddAll(c ...T) bool {
+	for _, e := range c {
+		ms.AddN(e, 1)
+	}
+
+	return len(c) > 0
+}
+
+// AddN adds n occurrences of the specified element to this multiset, and returns the number of
+// occurrences of the element before the operation. It does nothing if n is not positive.
+func (ms *HashMultiSet[T]) AddN(e T, n int) int {
+	old := ms.counts[e]
+	if n <= 0 {
+		return old
+	}
+
+	ms.counts[e] = old + n
+	ms.size += n
+
+	return old
+}
+
+// Clear removes all of the elements from this multiset.
+func (ms *HashMultiSet[T]) Clear() {
+	ms.counts = make(map[T]int)
+	ms.size = 0
+}
+
+// Clone returns a copy of this multiset.
+func (ms *HashMultiSet[T]) Clone() collection.MultiSet[T] {
+	newSet := NewHashMultiSet[T]()
+	for e, n := range ms.counts {
+		newSet.counts[e] = n
+	}
+	newSet.size = ms.size
+
+	return newSet
+}
+
+// Contains returns true if this multiset contains at least one occurrence of the specified element.
+func (ms *HashMultiSet[T]) Contains(e T) bool {
+	_, found := ms.counts[e]
+
+	return found
+}
+
+// ContainsAll returns true if this multiset contains all of the specified elements.
+func (ms *HashMultiSet[T]) ContainsAll(c ...T) bool {
+	for _, e := range c {
+		if _, found := ms.counts[e]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Count returns the number of occurrences of the specified element in this multiset.
+func (ms *HashMultiSet[T]) Count(e T) int {
+	return ms.counts[e]
+}
+
+// ElementSet returns a new set of the distinct elements in this multiset.
+func (ms *HashMultiSet[T]) ElementSet() collection.Set[T] {
+	elements := set.NewHashSet[T]()
+	for e := range ms.counts {
+		elements.Add(e)
+	}
+
+	return elements
+}
+
+// Equals compares this multiset with the object pass from parameter. Two multisets are equal if
+// they contain the same elements with the same counts.
+func (ms *HashMultiSet[T]) Equals(o any) bool {
+	oms, ok := o.(*HashMultiSet[T])
+	if !ok {
+		return false
+	}
+
+	if ms.size != oms.size || len(ms.counts) != len(oms.counts) {
+		return false
+	}
+
+	for e, n := range ms.counts {
+		if oms.counts[e] != n {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each occurrence of the elements in this multiset until
+// all elements have been processed or the handler returns an error. The occurrences of an element
+// are processed consecutively.
+func (ms *HashMultiSet[T]) ForEach(handler func(e T) error) error {
+	for e, n := range ms.counts {
+		for i := 0; i < n; i++ {
+			if err := handler(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if this multiset contains no elements.
+func (ms *HashMultiSet[T]) IsEmpty() bool {
+	return ms.size == 0
+}
+
+// MostCommon returns the n most common elements and their counts in descending order of the
+// counts, the order of the elements with the same count is unspecified. All elements are returned
+// if n is not positive or greater than the number of distinct elements.
+func (ms *HashMultiSet[T]) MostCommon(n int) []collection.MultiSetEntry[T] {
+	entries := make([]collection.MultiSetEntry[T], 0, len(ms.counts))
+	for e, count := range ms.counts {
+		entries = append(entries, collection.MultiSetEntry[T]{Element: e, Count: count})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Count > entries[j].Count
+	})
+
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+
+	return entries
+}
+
+// Remove removes an occurrence of the specified element from this multiset.
+func (ms *HashMultiSet[T]) Remove(e T) bool {
+	return ms.RemoveN(e, 1) > 0
+}
+
+// RemoveAll removes all occurrences of the specified elements from this multiset.
+func (ms *HashMultiSet[T]) RemoveAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if ms.SetCount(e, 0) > 0 {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// RemoveIf removes all occurrences of the elements of this multiset that satisfy the given
+// predicate.
+func (ms *HashMultiSet[T]) RemoveIf(filter func(T) bool) bool {
+	isChanged := false
+
+	for e, n := range ms.counts {
+		if filter(e) {
+			delete(ms.counts, e)
+			ms.size -= n
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// RemoveN removes n occurrences of the specified element from this multiset, and returns the
+// number of occurrences of the element before the operation. All occurrences are removed if there
+// are fewer than n occurrences.
+func (ms *HashMultiSet[T]) RemoveN(e T, n int) int {
+	old := ms.counts[e]
+	if n <= 0 || old == 0 {
+		return old
+	}
+
+	ms.SetCount(e, old-n)
+
+	return old
+}
+
+// RetainAll retains only the elements in this multiset that are contained in the specified
+// collection, the counts of the retained elements are not changed.
+func (ms *HashMultiSet[T]) RetainAll(c ...T) bool {
+	cSet := set.NewHashSetFrom(c...)
+
+	return ms.RemoveIf(func(e T) bool {
+		return !cSet.Contains(e)
+	})
+}
+
+// SetCount sets the number of occurrences of the specified element, and returns the number of
+// occurrences of the element before the operation. The element is removed if n is not positive.
+func (ms *HashMultiSet[T]) SetCount(e T, n int) int {
+	old := ms.counts[e]
+
+	if n <= 0 {
+		delete(ms.counts, e)
+		n = 0
+	} else {
+		ms.counts[e] = n
+	}
+	ms.size += n - old
+
+	return old
+}
+
+// Size returns the total number of occurrences of all elements in this multiset.
+func (ms *HashMultiSet[T]) Size() int {
+	return ms.size
+}
+
+// String returns the string representation of this multiset.
+func (ms *HashMultiSet[T]) String() string {
+	buf := bytes.NewBufferString("multiset[")
+	first := true
+	for e, n := range ms.counts {
+		if !first {
+			buf.WriteString(" ")
+		}
+		first = false
+		buf.WriteString(internal.ValueString(e))
+		buf.WriteString(": ")
+		buf.WriteString(internal.ValueString(n))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// ToSlice returns a slice containing all occurrences of the elements in this multiset.
+func (ms *HashMultiSet[T]) ToSlice() []T {
+	slice := make([]T, 0, ms.size)
+	for e, n := range ms.counts {
+		for i := 0; i < n; i++ {
+			slice = append(slice, e)
+		}
+	}
+
+	return slice
+}
+
+// MarshalJSON marshals the multiset as a JSON array that contains all occurrences of the elements.
+func (ms *HashMultiSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ms.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the multiset, each item of the array is an occurrence
+// of the element.
+func (ms *HashMultiSet[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	ms.Clear()
+	ms.AddAll(items...)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+//go:build go1.23
+
+package multiset
+
+import "iter"
+
+// Iter returns an iterator of all occurrences of the elements in this multiset.
+func (ms *HashMultiSet[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e, n := range ms.counts {
+			for i := 0; i < n; i++ {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// EntryIter returns an iterator of all distinct elements and their counts in this multiset.
+func (ms *HashMultiSet[T]) EntryIter() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for e, n := range ms.counts {
+			if !yield(e, n) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+//go:build go1.23
+
+package multiset
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestHashMultiSetIter(t *testing.T) {
+	a := assert.New(t)
+	ms := NewHashMultiSetFrom(1, 2, 2)
+
+	res := make([]int, 0, ms.Size())
+	for e := range ms.Iter() {
+		res = append(res, e)
+	}
+	sort.Ints(res)
+	a.EqualNow([]int{1, 2, 2}, res)
+
+	counts := make(map[int]int)
+	for e, n := range ms.EntryIter() {
+		counts[e] = n
+	}
+	a.EqualNow(2, len(counts))
+	a.EqualNow(1, counts[1])
+	a.EqualNow(2, counts[2])
+
+	for range ms.Iter() {
+		// yield should returns false
+		break
+	}
+	for range ms.EntryIter() {
+		// yield should returns false
+		break
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !go1.23
+
+package multiset
+
+// Iter returns a channel of all occurrences of the elements in this multiset.
+func (ms *HashMultiSet[T]) Iter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for e, n := range ms.counts {
+			for i := 0; i < n; i++ {
+				ch <- e
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
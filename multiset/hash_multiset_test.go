@@ -0,0 +1,141 @@
+package multiset
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/go-assert"
+)
+
+func TestHashMultiSetCount(t *testing.T) {
+	a := assert.New(t)
+	ms := NewHashMultiSet[string]()
+
+	a.TrueNow(ms.IsEmpty())
+	a.EqualNow(0, ms.Count("a"))
+
+	a.TrueNow(ms.Add("a"))
+	a.TrueNow(ms.AddAll("b", "a"))
+	a.NotTrueNow(ms.AddAll())
+	a.EqualNow(2, ms.AddN("a", 3))
+	a.EqualNow(0, ms.AddN("c", 0))
+	a.EqualNow(6, ms.Size())
+	a.EqualNow(5, ms.Count("a"))
+	a.EqualNow(1, ms.Count("b"))
+	a.NotTrueNow(ms.Contains("c"))
+
+	a.EqualNow(5, ms.RemoveN("a", 2))
+	a.EqualNow(3, ms.Count("a"))
+	a.EqualNow(3, ms.RemoveN("a", 0))
+	a.EqualNow(0, ms.RemoveN("c", 1))
+	a.EqualNow(1, ms.RemoveN("b", 10))
+	a.NotTrueNow(ms.Contains("b"))
+	a.EqualNow(3, ms.Size())
+
+	a.EqualNow(0, ms.SetCount("c", 4))
+	a.EqualNow(4, ms.SetCount("c", 2))
+	a.EqualNow(3, ms.SetCount("a", -1))
+	a.NotTrueNow(ms.Contains("a"))
+	a.EqualNow(2, ms.Size())
+	a.EqualNow("multiset[c: 2]", ms.String())
+}
+
+func TestHashMultiSetCollection(t *testing.T) {
+	a := assert.New(t)
+	ms := NewHashMultiSetFrom(1, 2, 2, 3, 3, 3)
+
+	a.TrueNow(ms.Contains(2))
+	a.TrueNow(ms.ContainsAll(1, 3))
+	a.NotTrueNow(ms.ContainsAll(1, 4))
+
+	slice := ms.ToSlice()
+	sort.Ints(slice)
+	a.EqualNow([]int{1, 2, 2, 3, 3, 3}, slice)
+
+	res := make([]int, 0, ms.Size())
+	a.NilNow(ms.ForEach(func(e int) error {
+		res = append(res, e)
+		return nil
+	}))
+	sort.Ints(res)
+	a.EqualNow(slice, res)
+	expectedErr := errors.New("expected error")
+	a.EqualNow(expectedErr, ms.ForEach(func(e int) error {
+		return expectedErr
+	}))
+
+	clone := ms.Clone()
+	a.TrueNow(ms.Equals(clone))
+	clone.Add(1)
+	a.NotTrueNow(ms.Equals(clone))
+	a.NotTrueNow(ms.Equals(NewHashMultiSetFrom(1, 2, 3, 3, 3, 3)))
+	a.NotTrueNow(ms.Equals(set.NewHashSetFrom(1, 2, 3)))
+
+	a.TrueNow(ms.ElementSet().Equals(set.NewHashSetFrom(1, 2, 3)))
+
+	a.TrueNow(ms.Remove(3))
+	a.EqualNow(2, ms.Count(3))
+	a.NotTrueNow(ms.Remove(4))
+	a.TrueNow(ms.RemoveAll(2, 4))
+	a.NotTrueNow(ms.RemoveAll(2))
+	a.EqualNow(3, ms.Size())
+	a.TrueNow(ms.RetainAll(3))
+	a.NotTrueNow(ms.RetainAll(3))
+	a.EqualNow(2, ms.Size())
+	a.TrueNow(ms.RemoveIf(func(e int) bool { return e == 3 }))
+	a.TrueNow(ms.IsEmpty())
+
+	ms.AddAll(1, 2)
+	ms.Clear()
+	a.TrueNow(ms.IsEmpty())
+	a.EqualNow(0, ms.Count(1))
+}
+
+func TestHashMultiSetMostCommon(t *testing.T) {
+	a := assert.New(t)
+	ms := NewHashMultiSet[string]()
+	ms.AddN("a", 3)
+	ms.AddN("b", 5)
+	ms.AddN("c", 1)
+
+	a.EqualNow([]collection.MultiSetEntry[string]{
+		{Element: "b", Count: 5},
+		{Element: "a", Count: 3},
+	}, ms.MostCommon(2))
+	a.EqualNow([]collection.MultiSetEntry[string]{
+		{Element: "b", Count: 5},
+		{Element: "a", Count: 3},
+		{Element: "c", Count: 1},
+	}, ms.MostCommon(0))
+	a.EqualNow(3, len(ms.MostCommon(10)))
+}
+
+func TestHashMultiSetJSON(t *testing.T) {
+	a := assert.New(t)
+	ms := NewHashMultiSetFrom("a", "a")
+
+	b, err := json.Marshal(ms)
+	a.NilNow(err)
+	a.EqualNow(`["a","a"]`, string(b))
+
+	ms2 := NewHashMultiSetFrom("c")
+	a.NilNow(json.Unmarshal([]byte(`["a","b","a"]`), ms2))
+	a.EqualNow(2, ms2.Count("a"))
+	a.EqualNow(1, ms2.Count("b"))
+	a.EqualNow(0, ms2.Count("c"))
+	a.EqualNow(3, ms2.Size())
+
+	a.NotNilNow(json.Unmarshal([]byte(`{}`), ms2))
+}
+
+func BenchmarkHashMultiSet_Add(b *testing.B) {
+	ms := NewHashMultiSet[int]()
+
+	for i := 0; i < b.N; i++ {
+		ms.Add(i % 1024)
+	}
+}
@@ -0,0 +1,92 @@
+package collection
+
+import (
+	"context"
+	"time"
+)
+
+// Queue is a collection that holds elements prior to processing, it orders elements in the FIFO
+// (first-in, first-out) manner typically.
+type Queue[T any] interface {
+	Collection[T]
+
+	// Offer inserts the specified element into this queue, and returns true if the element was
+	// added.
+	Offer(e T) bool
+
+	// Peek returns the head of this queue without removing it, or false if this queue is empty.
+	Peek() (T, bool)
+
+	// Poll removes and returns the head of this queue, or false if this queue is empty.
+	Poll() (T, bool)
+}
+
+// Deque is a double-ended queue that supports element insertion and removal at both ends.
+type Deque[T any] interface {
+	Queue[T]
+	DequeIter[T]
+
+	// OfferFirst inserts the specified element at the front of this deque, and returns true if the
+	// element was added.
+	OfferFirst(e T) bool
+
+	// OfferLast inserts the specified element at the end of this deque, and returns true if the
+	// element was added.
+	OfferLast(e T) bool
+
+	// PeekFirst returns the first element of this deque without removing it, or false if this deque
+	// is empty.
+	PeekFirst() (T, bool)
+
+	// PeekLast returns the last element of this deque without removing it, or false if this deque is
+	// empty.
+	PeekLast() (T, bool)
+
+	// PollFirst removes and returns the first element of this deque, or false if this deque is empty.
+	PollFirst() (T, bool)
+
+	// PollLast removes and returns the last element of this deque, or false if this deque is empty.
+	PollLast() (T, bool)
+}
+
+// BlockingQueue is a thread-safe queue that additionally supports operations that wait for the
+// queue to become non-empty when retrieving an element, and wait for space to become available in
+// the queue when storing an element.
+type BlockingQueue[T any] interface {
+	Queue[T]
+
+	// DrainTo removes at most max elements from this queue and adds them to the specified
+	// collection, and returns the number of elements transferred. All available elements are
+	// transferred if max is not positive.
+	DrainTo(c Collection[T], max int) int
+
+	// OfferTimeout inserts the specified element into this queue, waiting up to the specified
+	// duration for space to become available. It returns false if the duration elapsed.
+	OfferTimeout(e T, timeout time.Duration) bool
+
+	// PollTimeout removes and returns the head of this queue, waiting up to the specified duration
+	// for an element to become available. It returns false if the duration elapsed.
+	PollTimeout(timeout time.Duration) (T, bool)
+
+	// Put inserts the specified element into this queue, waiting for space to become available if
+	// necessary.
+	Put(e T)
+
+	// PutContext inserts the specified element into this queue, waiting for space to become
+	// available until the context is done. It returns the error of the context if the element was
+	// not inserted.
+	PutContext(ctx context.Context, e T) error
+
+	// RemainingCapacity returns the number of elements that this queue can accept without
+	// blocking.
+	RemainingCapacity() int
+
+	// Take removes and returns the head of this queue, waiting for an element to become available
+	// if necessary.
+	Take() T
+
+	// TakeContext removes and returns the head of this queue, waiting for an element to become
+	// available until the context is done. It returns the error of the context if no element was
+	// removed.
+	TakeContext(ctx context.Context) (T, error)
+}
@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"github.com/ghosind/collection"
+)
+
+// ArrayBlockingQueue is a bounded blocking queue backed by a fixed size ring buffer, it orders the
+// elements in the FIFO manner. The queue must be created by NewArrayBlockingQueue.
+type ArrayBlockingQueue[T any] struct {
+	blockingQueue[T]
+}
+
+// NewArrayBlockingQueue creates a new ArrayBlockingQueue with the specified capacity. It panics if
+// the capacity is not positive.
+func NewArrayBlockingQueue[T any](capacity int) *ArrayBlockingQueue[T] {
+	if capacity <= 0 {
+		panic(collection.ErrInvalidCapacity)
+	}
+
+	q := new(ArrayBlockingQueue[T])
+	q.data = &ArrayDeque[T]{elements: make([]T, capacity)}
+	q.capacity = capacity
+
+	return q
+}
+
+// Capacity returns the capacity of this queue.
+func (q *ArrayBlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// Clone returns a copy of this queue with the same capacity.
+func (q *ArrayBlockingQueue[T]) Clone() collection.BlockingQueue[T] {
+	newQueue := NewArrayBlockingQueue[T](q.capacity)
+	newQueue.AddAll(q.ToSlice()...)
+
+	return newQueue
+}
+
+// Equals compares this queue with the object pass from parameter.
+func (q *ArrayBlockingQueue[T]) Equals(o any) bool {
+	oq, ok := o.(*ArrayBlockingQueue[T])
+	if !ok {
+		return false
+	}
+
+	return q.equals(&oq.blockingQueue)
+}
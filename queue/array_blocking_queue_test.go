@@ -0,0 +1,34 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func arrayBlockingQueueConstructor(capacity int) collection.BlockingQueue[int] {
+	return NewArrayBlockingQueue[int](capacity)
+}
+
+func TestArrayBlockingQueue(t *testing.T) {
+	a := assert.New(t)
+
+	testBlockingQueue(a, arrayBlockingQueueConstructor)
+}
+
+func TestArrayBlockingQueueCapacity(t *testing.T) {
+	a := assert.New(t)
+
+	a.PanicOfNow(func() {
+		NewArrayBlockingQueue[int](0)
+	}, collection.ErrInvalidCapacity)
+
+	q := NewArrayBlockingQueue[int](3)
+	a.EqualNow(3, q.Capacity())
+	q.AddAll(1, 2)
+
+	clone := q.Clone()
+	a.TrueNow(q.Equals(clone))
+	a.EqualNow(1, clone.RemainingCapacity())
+}
@@ -0,0 +1,351 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+const (
+	minArrayDequeCapacity = 8
+)
+
+// ArrayDeque is a resizable ring buffer implementation of the Deque interface. It has amortized
+// constant time for insertion and removal at both ends.
+type ArrayDeque[T any] struct {
+	elements []T
+	head     int
+	size     int
+}
+
+// NewArrayDeque creates and returns a new empty deque.
+func NewArrayDeque[T any]() *ArrayDeque[T] {
+	return new(ArrayDeque[T])
+}
+
+// NewArrayDequeFrom creates and returns a new deque containing the elements of the provided
+// collection.
+func NewArrayDequeFrom[T any](c ...T) *ArrayDeque[T] {
+	d := new(ArrayDeque[T])
+	d.elements = make([]T, arrayDequeCapacity(len(c)))
+	copy(d.elements, c)
+	d.size = len(c)
+
+	return d
+}
+
+// Add adds the specified element to the end of this deque.
+func (d *ArrayDeque[T]) Add(e T) bool {
+	return d.OfferLast(e)
+}
+
+// AddAll adds all of the elements to the end of this deque.
+func (d *ArrayDeque[T]) AddAll(c ...T) bool {
+	for _, e := range c {
+		d.OfferLast(e)
+	}
+
+	return len(c) > 0
+}
+
+// Clear removes all of the elements from this deque.
+func (d *ArrayDeque[T]) Clear() {
+	var zero T
+	for i := 0; i < d.size; i++ {
+		d.elements[d.index(i)] = zero
+	}
+
+	d.head = 0
+	d.size = 0
+}
+
+// Clone returns a copy of this deque.
+func (d *ArrayDeque[T]) Clone() collection.Deque[T] {
+	return NewArrayDequeFrom(d.ToSlice()...)
+}
+
+// Contains returns true if this deque contains the specified element.
+func (d *ArrayDeque[T]) Contains(e T) bool {
+	for i := 0; i < d.size; i++ {
+		if internal.Equal(d.elements[d.index(i)], e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsAll returns true if this deque contains all of the specified elements.
+func (d *ArrayDeque[T]) ContainsAll(c ...T) bool {
+	slice := d.ToSlice()
+	cache := internal.MakeSliceCacheMap(slice)
+	defer internal.ReleaseCacheMap(cache)
+
+	for _, e := range c {
+		if !internal.InSlice(e, slice, cache) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals compares this deque with the object pass from parameter.
+func (d *ArrayDeque[T]) Equals(o any) bool {
+	od, ok := o.(*ArrayDeque[T])
+	if !ok {
+		return false
+	}
+
+	if d.size != od.size {
+		return false
+	}
+
+	for i := 0; i < d.size; i++ {
+		if !internal.Equal(d.elements[d.index(i)], od.elements[od.index(i)]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each element in this deque from first to last until all
+// elements have been processed or the handler returns an error.
+func (d *ArrayDeque[T]) ForEach(handler func(e T) error) error {
+	for i := 0; i < d.size; i++ {
+		if err := handler(d.elements[d.index(i)]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if this deque contains no elements.
+func (d *ArrayDeque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Offer inserts the specified element at the end of this deque.
+func (d *ArrayDeque[T]) Offer(e T) bool {
+	return d.OfferLast(e)
+}
+
+// OfferFirst inserts the specified element at the front of this deque.
+func (d *ArrayDeque[T]) OfferFirst(e T) bool {
+	if d.size == len(d.elements) {
+		d.grow()
+	}
+
+	d.head = (d.head - 1 + len(d.elements)) % len(d.elements)
+	d.elements[d.head] = e
+	d.size++
+
+	return true
+}
+
+// OfferLast inserts the specified element at the end of this deque.
+func (d *ArrayDeque[T]) OfferLast(e T) bool {
+	if d.size == len(d.elements) {
+		d.grow()
+	}
+
+	d.elements[d.index(d.size)] = e
+	d.size++
+
+	return true
+}
+
+// Peek returns the first element of this deque without removing it, or false if this deque is
+// empty.
+func (d *ArrayDeque[T]) Peek() (T, bool) {
+	return d.PeekFirst()
+}
+
+// PeekFirst returns the first element of this deque without removing it, or false if this deque is
+// empty.
+func (d *ArrayDeque[T]) PeekFirst() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.elements[d.head], true
+}
+
+// PeekLast returns the last element of this deque without removing it, or false if this deque is
+// empty.
+func (d *ArrayDeque[T]) PeekLast() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.elements[d.index(d.size-1)], true
+}
+
+// Poll removes and returns the first element of this deque, or false if this deque is empty.
+func (d *ArrayDeque[T]) Poll() (T, bool) {
+	return d.PollFirst()
+}
+
+// PollFirst removes and returns the first element of this deque, or false if this deque is empty.
+func (d *ArrayDeque[T]) PollFirst() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	e := d.elements[d.head]
+	d.elements[d.head] = zero
+	d.head = (d.head + 1) % len(d.elements)
+	d.size--
+
+	return e, true
+}
+
+// PollLast removes and returns the last element of this deque, or false if this deque is empty.
+func (d *ArrayDeque[T]) PollLast() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.size - 1)
+	e := d.elements[i]
+	d.elements[i] = zero
+	d.size--
+
+	return e, true
+}
+
+// Remove removes all occurrences of the specified element from this deque. Returns true if this
+// deque contained the specified element.
+func (d *ArrayDeque[T]) Remove(e T) bool {
+	return d.RemoveIf(func(v T) bool {
+		return internal.Equal(v, e)
+	})
+}
+
+// RemoveAll removes all occurrences of the specified elements from this deque. Returns true if
+// this deque contained any of the specified elements.
+func (d *ArrayDeque[T]) RemoveAll(c ...T) bool {
+	if len(c) == 0 {
+		return false
+	}
+
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return d.RemoveIf(func(v T) bool {
+		return internal.InSlice(v, c, cache)
+	})
+}
+
+// RemoveIf removes all of the elements of this deque that satisfy the given predicate. Returns
+// true if any elements were removed.
+func (d *ArrayDeque[T]) RemoveIf(f func(T) bool) bool {
+	n := 0
+	for i := 0; i < d.size; i++ {
+		e := d.elements[d.index(i)]
+		if !f(e) {
+			d.elements[d.index(n)] = e
+			n++
+		}
+	}
+
+	var zero T
+	for i := n; i < d.size; i++ {
+		d.elements[d.index(i)] = zero
+	}
+
+	isChanged := n != d.size
+	d.size = n
+
+	return isChanged
+}
+
+// RetainAll retains only the elements in this deque that are contained in the specified elements.
+// Returns true if this deque changed as a result of the call.
+func (d *ArrayDeque[T]) RetainAll(c ...T) bool {
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return d.RemoveIf(func(v T) bool {
+		return !internal.InSlice(v, c, cache)
+	})
+}
+
+// Size returns the number of elements in this deque.
+func (d *ArrayDeque[T]) Size() int {
+	return d.size
+}
+
+// String returns the string representation of this deque.
+func (d *ArrayDeque[T]) String() string {
+	buf := bytes.NewBufferString("deque[")
+	for i := 0; i < d.size; i++ {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(d.elements[d.index(i)]))
+	}
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// ToSlice returns a slice containing all of the elements in this deque from first to last.
+func (d *ArrayDeque[T]) ToSlice() []T {
+	slice := make([]T, d.size)
+	for i := 0; i < d.size; i++ {
+		slice[i] = d.elements[d.index(i)]
+	}
+
+	return slice
+}
+
+// MarshalJSON marshals the deque as a JSON array.
+func (d *ArrayDeque[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the deque.
+func (d *ArrayDeque[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	*d = *NewArrayDequeFrom(items...)
+	return nil
+}
+
+// index returns the position in the underlying slice of the i-th element in this deque.
+func (d *ArrayDeque[T]) index(i int) int {
+	return (d.head + i) % len(d.elements)
+}
+
+// grow doubles the capacity of the underlying slice, and moves the elements to the beginning of
+// the new slice.
+func (d *ArrayDeque[T]) grow() {
+	elements := make([]T, arrayDequeCapacity(len(d.elements)*2))
+	for i := 0; i < d.size; i++ {
+		elements[i] = d.elements[d.index(i)]
+	}
+
+	d.elements = elements
+	d.head = 0
+}
+
+// arrayDequeCapacity returns the capacity of the underlying slice to hold n elements.
+func arrayDequeCapacity(n int) int {
+	if n < minArrayDequeCapacity {
+		return minArrayDequeCapacity
+	}
+
+	return n
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package queue
+
+import "iter"
+
+// Iter returns an iterator of all elements in this deque from first to last.
+func (d *ArrayDeque[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(d.elements[d.index(i)]) {
+				break
+			}
+		}
+	}
+}
+
+// DescendingIter returns an iterator of all elements in this deque from last to first.
+func (d *ArrayDeque[T]) DescendingIter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.size - 1; i >= 0; i-- {
+			if !yield(d.elements[d.index(i)]) {
+				break
+			}
+		}
+	}
+}
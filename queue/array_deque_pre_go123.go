@@ -0,0 +1,27 @@
+//go:build !go1.23
+
+package queue
+
+// Iter returns a channel of all elements in this deque from first to last.
+func (d *ArrayDeque[T]) Iter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for i := 0; i < d.size; i++ {
+			ch <- d.elements[d.index(i)]
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// DescendingIter returns a channel of all elements in this deque from last to first.
+func (d *ArrayDeque[T]) DescendingIter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for i := d.size - 1; i >= 0; i-- {
+			ch <- d.elements[d.index(i)]
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,48 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func arrayDequeConstructor(initData ...int) collection.Deque[int] {
+	if len(initData) == 0 {
+		return NewArrayDeque[int]()
+	}
+	return NewArrayDequeFrom(initData...)
+}
+
+func TestArrayDeque(t *testing.T) {
+	a := assert.New(t)
+
+	testDeque(a, arrayDequeConstructor)
+}
+
+func TestArrayDequeZeroValue(t *testing.T) {
+	a := assert.New(t)
+	var d ArrayDeque[int]
+
+	a.TrueNow(d.IsEmpty())
+	a.EqualNow("deque[]", d.String())
+	d.OfferFirst(1)
+	d.OfferLast(2)
+	a.EqualNow([]int{1, 2}, d.ToSlice())
+
+	clone := d.Clone()
+	a.TrueNow(d.Equals(clone))
+	clone.Offer(3)
+	a.EqualNow(2, d.Size())
+}
+
+func BenchmarkArrayDeque_OfferPoll(b *testing.B) {
+	d := NewArrayDeque[int]()
+
+	for i := 0; i < b.N; i++ {
+		d.Offer(i)
+		if i%2 == 1 {
+			d.Poll()
+		}
+	}
+}
@@ -0,0 +1,407 @@
+package queue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// blockingQueue is the common implementation of the blocking queues, it guards a deque with a
+// mutex and wakes up the waiting goroutines by closing the notification channels.
+type blockingQueue[T any] struct {
+	data     collection.Deque[T]
+	capacity int
+	mu       sync.Mutex
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// Add inserts the specified element into this queue if it is possible to do so immediately, and
+// returns false if no space is currently available.
+func (q *blockingQueue[T]) Add(e T) bool {
+	return q.Offer(e)
+}
+
+// AddAll inserts the specified elements into this queue until no space is currently available,
+// and returns true if any element was inserted.
+func (q *blockingQueue[T]) AddAll(c ...T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	isChanged := false
+	for _, e := range c {
+		if q.data.Size() >= q.capacity {
+			break
+		}
+		q.enqueue(e)
+		isChanged = true
+	}
+
+	return isChanged
+}
+
+// Clear removes all of the elements from this queue.
+func (q *blockingQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.data.Clear()
+	q.signalNotFull()
+}
+
+// Contains returns true if this queue contains the specified element.
+func (q *blockingQueue[T]) Contains(e T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.Contains(e)
+}
+
+// ContainsAll returns true if this queue contains all of the specified elements.
+func (q *blockingQueue[T]) ContainsAll(c ...T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.ContainsAll(c...)
+}
+
+// DrainTo removes at most max elements from this queue and adds them to the specified collection,
+// and returns the number of elements transferred. All available elements are transferred if max
+// is not positive. The elements are removed no matter what Add of the collection returns, except
+// that it stops when the collection is a blocking queue that is full, and the rejected element is
+// kept at the head of this queue. The collection is added to while this queue is locked, so it must
+// not be draining into this queue at the same time. Nothing is transferred if the collection is
+// this queue.
+func (q *blockingQueue[T]) DrainTo(c collection.Collection[T], max int) int {
+	if b, ok := c.(interface{ base() *blockingQueue[T] }); ok && b.base() == q {
+		return 0
+	}
+	bq, bounded := c.(collection.BlockingQueue[T])
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	n := 0
+	for max <= 0 || n < max {
+		e, ok := q.data.PeekFirst()
+		if !ok {
+			break
+		}
+		if bounded {
+			if !bq.Offer(e) {
+				break
+			}
+		} else {
+			c.Add(e)
+		}
+		q.data.PollFirst()
+		n++
+	}
+	if n > 0 {
+		q.signalNotFull()
+	}
+
+	return n
+}
+
+// ForEach performs the given handler for each element in a snapshot of this queue from head to
+// tail until all elements have been processed or the handler returns an error.
+func (q *blockingQueue[T]) ForEach(handler func(e T) error) error {
+	for _, e := range q.ToSlice() {
+		if err := handler(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if this queue contains no elements.
+func (q *blockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Offer inserts the specified element into this queue if it is possible to do so immediately, and
+// returns false if no space is currently available.
+func (q *blockingQueue[T]) Offer(e T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.data.Size() >= q.capacity {
+		return false
+	}
+	q.enqueue(e)
+
+	return true
+}
+
+// OfferTimeout inserts the specified element into this queue, waiting up to the specified duration
+// for space to become available. It returns false if the duration elapsed.
+func (q *blockingQueue[T]) OfferTimeout(e T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.PutContext(ctx, e) == nil
+}
+
+// Peek returns the head of this queue without removing it, or false if this queue is empty.
+func (q *blockingQueue[T]) Peek() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.PeekFirst()
+}
+
+// Poll removes and returns the head of this queue, or false if this queue is empty.
+func (q *blockingQueue[T]) Poll() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.data.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+
+	return q.dequeue(), true
+}
+
+// PollTimeout removes and returns the head of this queue, waiting up to the specified duration for
+// an element to become available. It returns false if the duration elapsed.
+func (q *blockingQueue[T]) PollTimeout(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	e, err := q.TakeContext(ctx)
+	return e, err == nil
+}
+
+// Put inserts the specified element into this queue, waiting for space to become available if
+// necessary.
+func (q *blockingQueue[T]) Put(e T) {
+	_ = q.PutContext(context.Background(), e)
+}
+
+// PutContext inserts the specified element into this queue, waiting for space to become available
+// until the context is done. It returns the error of the context if the element was not inserted.
+func (q *blockingQueue[T]) PutContext(ctx context.Context, e T) error {
+	q.mu.Lock()
+	for q.data.Size() >= q.capacity {
+		if q.notFull == nil {
+			q.notFull = make(chan struct{})
+		}
+		ch := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		q.mu.Lock()
+	}
+	defer q.mu.Unlock()
+
+	q.enqueue(e)
+
+	return nil
+}
+
+// RemainingCapacity returns the number of elements that this queue can accept without blocking.
+func (q *blockingQueue[T]) RemainingCapacity() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.capacity - q.data.Size()
+}
+
+// Remove removes all occurrences of the specified element from this queue.
+func (q *blockingQueue[T]) Remove(e T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.afterRemove(q.data.Remove(e))
+}
+
+// RemoveAll removes all occurrences of the specified elements from this queue.
+func (q *blockingQueue[T]) RemoveAll(c ...T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.afterRemove(q.data.RemoveAll(c...))
+}
+
+// RemoveIf removes all of the elements of this queue that satisfy the given predicate.
+func (q *blockingQueue[T]) RemoveIf(f func(T) bool) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.afterRemove(q.data.RemoveIf(f))
+}
+
+// RetainAll retains only the elements in this queue that are contained in the specified elements.
+func (q *blockingQueue[T]) RetainAll(c ...T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.afterRemove(q.data.RetainAll(c...))
+}
+
+// Size returns the number of elements in this queue.
+func (q *blockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.Size()
+}
+
+// String returns the string representation of this queue.
+func (q *blockingQueue[T]) String() string {
+	buf := bytes.NewBufferString("queue[")
+	for i, e := range q.ToSlice() {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e))
+	}
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// Take removes and returns the head of this queue, waiting for an element to become available if
+// necessary.
+func (q *blockingQueue[T]) Take() T {
+	e, _ := q.TakeContext(context.Background())
+	return e
+}
+
+// TakeContext removes and returns the head of this queue, waiting for an element to become
+// available until the context is done. It returns the error of the context if no element was
+// removed.
+func (q *blockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for q.data.IsEmpty() {
+		if q.notEmpty == nil {
+			q.notEmpty = make(chan struct{})
+		}
+		ch := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+
+		q.mu.Lock()
+	}
+	defer q.mu.Unlock()
+
+	return q.dequeue(), nil
+}
+
+// ToSlice returns a slice containing all of the elements in this queue from head to tail.
+func (q *blockingQueue[T]) ToSlice() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.data.ToSlice()
+}
+
+// MarshalJSON marshals the queue as a JSON array from head to tail.
+func (q *blockingQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the queue, it replaces all of the elements in this
+// queue. It returns ErrQueueFull if the array has more elements than the capacity of this queue.
+func (q *blockingQueue[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(items) > q.capacity {
+		return collection.ErrQueueFull
+	}
+
+	q.data.Clear()
+	q.signalNotFull()
+	for _, e := range items {
+		q.enqueue(e)
+	}
+
+	return nil
+}
+
+// equals returns true if both queues contain the same elements in the same order.
+func (q *blockingQueue[T]) equals(o *blockingQueue[T]) bool {
+	if q == o {
+		return true
+	}
+
+	s1 := q.ToSlice()
+	s2 := o.ToSlice()
+	if len(s1) != len(s2) {
+		return false
+	}
+
+	for i := range s1 {
+		if !internal.Equal(s1[i], s2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// afterRemove wakes up the goroutines waiting for space if any element was removed.
+func (q *blockingQueue[T]) afterRemove(isChanged bool) bool {
+	if isChanged {
+		q.signalNotFull()
+	}
+
+	return isChanged
+}
+
+// base returns the common implementation of the blocking queue, it is used to detect the queue
+// itself in DrainTo.
+func (q *blockingQueue[T]) base() *blockingQueue[T] {
+	return q
+}
+
+// enqueue inserts the element at the tail, the caller must hold the lock and ensure that there is
+// space available.
+func (q *blockingQueue[T]) enqueue(e T) {
+	q.data.OfferLast(e)
+	if q.notEmpty != nil {
+		close(q.notEmpty)
+		q.notEmpty = nil
+	}
+}
+
+// dequeue removes and returns the head, the caller must hold the lock and ensure that the queue is
+// not empty.
+func (q *blockingQueue[T]) dequeue() T {
+	e, _ := q.data.PollFirst()
+	q.signalNotFull()
+
+	return e
+}
+
+func (q *blockingQueue[T]) signalNotFull() {
+	if q.notFull != nil {
+		close(q.notFull)
+		q.notFull = nil
+	}
+}
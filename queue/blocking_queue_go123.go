@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package queue
+
+import "iter"
+
+// Iter returns an iterator of all elements in a snapshot of this queue from head to tail.
+func (q *blockingQueue[T]) Iter() iter.Seq[T] {
+	elements := q.ToSlice()
+
+	return func(yield func(T) bool) {
+		for _, e := range elements {
+			if !yield(e) {
+				break
+			}
+		}
+	}
+}
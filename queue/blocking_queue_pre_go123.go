@@ -0,0 +1,17 @@
+//go:build !go1.23
+
+package queue
+
+// Iter returns a channel of all elements in a snapshot of this queue from head to tail.
+func (q *blockingQueue[T]) Iter() <-chan T {
+	elements := q.ToSlice()
+
+	ch := make(chan T)
+	go func() {
+		for _, e := range elements {
+			ch <- e
+		}
+		close(ch)
+	}()
+	return ch
+}
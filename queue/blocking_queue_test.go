@@ -0,0 +1,249 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/go-assert"
+)
+
+type blockingQueueConstructor func(capacity int) collection.BlockingQueue[int]
+
+func testBlockingQueue(a *assert.Assertion, constructor blockingQueueConstructor) {
+	testBlockingQueueOfferPoll(a, constructor)
+	testBlockingQueuePutTake(a, constructor)
+	testBlockingQueueContext(a, constructor)
+	testBlockingQueueTimeout(a, constructor)
+	testBlockingQueueDrainTo(a, constructor)
+	testBlockingQueueCollection(a, constructor)
+	testBlockingQueueJSON(a, constructor)
+	testBlockingQueueConcurrent(a, constructor)
+}
+
+func testBlockingQueueOfferPoll(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(2)
+
+	_, ok := q.Peek()
+	a.NotTrueNow(ok)
+	_, ok = q.Poll()
+	a.NotTrueNow(ok)
+
+	a.TrueNow(q.Offer(1))
+	a.TrueNow(q.Add(2))
+	a.NotTrueNow(q.Offer(3))
+	a.EqualNow(0, q.RemainingCapacity())
+
+	e, ok := q.Peek()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	e, ok = q.Poll()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	a.EqualNow(1, q.RemainingCapacity())
+}
+
+func testBlockingQueuePutTake(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(1)
+	q.Put(1)
+
+	done := make(chan struct{})
+	go func() {
+		q.Put(2)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		a.TrueNow(false, "Put should block while the queue is full")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	a.EqualNow(1, q.Take())
+	<-done
+	a.EqualNow(2, q.Take())
+
+	res := make(chan int)
+	go func() {
+		res <- q.Take()
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.Put(3)
+	a.EqualNow(3, <-res)
+}
+
+func testBlockingQueueContext(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := q.TakeContext(ctx)
+	a.TrueNow(errors.Is(err, context.Canceled))
+
+	a.NilNow(q.PutContext(ctx, 1))
+	err = q.PutContext(ctx, 2)
+	a.TrueNow(errors.Is(err, context.Canceled))
+
+	e, err := q.TakeContext(ctx)
+	a.NilNow(err)
+	a.EqualNow(1, e)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = q.TakeContext(ctx)
+	a.TrueNow(errors.Is(err, context.DeadlineExceeded))
+}
+
+func testBlockingQueueTimeout(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(1)
+
+	_, ok := q.PollTimeout(5 * time.Millisecond)
+	a.NotTrueNow(ok)
+
+	a.TrueNow(q.OfferTimeout(1, 5*time.Millisecond))
+	a.NotTrueNow(q.OfferTimeout(2, 5*time.Millisecond))
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		q.Poll()
+	}()
+	a.TrueNow(q.OfferTimeout(2, time.Second))
+
+	e, ok := q.PollTimeout(time.Second)
+	a.TrueNow(ok)
+	a.EqualNow(2, e)
+}
+
+func testBlockingQueueDrainTo(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(10)
+	q.AddAll(1, 2, 3, 4, 5)
+
+	l := list.NewArrayList[int]()
+	a.EqualNow(2, q.DrainTo(l, 2))
+	a.EqualNow([]int{1, 2}, l.ToSlice())
+	a.EqualNow(3, q.DrainTo(l, 0))
+	a.EqualNow([]int{1, 2, 3, 4, 5}, l.ToSlice())
+	a.EqualNow(0, q.DrainTo(l, 0))
+	a.TrueNow(q.IsEmpty())
+
+	q.AddAll(1, 2, 3, 4, 5)
+	bounded := constructor(3)
+	bounded.Add(0)
+	a.EqualNow(2, q.DrainTo(bounded, 0))
+	a.EqualNow([]int{0, 1, 2}, bounded.ToSlice())
+	a.EqualNow([]int{3, 4, 5}, q.ToSlice())
+	a.EqualNow(0, q.DrainTo(bounded, 0))
+	a.EqualNow([]int{3, 4, 5}, q.ToSlice())
+
+	bounded.Clear()
+	a.EqualNow(1, q.DrainTo(bounded, 1))
+	a.EqualNow([]int{3}, bounded.ToSlice())
+	a.EqualNow([]int{4, 5}, q.ToSlice())
+
+	a.EqualNow(0, q.DrainTo(q, 0))
+	a.EqualNow([]int{4, 5}, q.ToSlice())
+
+	q.Clear()
+	q.AddAll(1, 2, 1, 3)
+	hs := set.NewHashSet[int]()
+	hs.Add(3)
+	a.EqualNow(4, q.DrainTo(hs, 0))
+	a.TrueNow(q.IsEmpty())
+	a.EqualNow(3, hs.Size())
+	a.TrueNow(hs.ContainsAll(1, 2, 3))
+}
+
+func testBlockingQueueCollection(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(5)
+
+	a.TrueNow(q.AddAll(1, 2, 3, 2, 4, 5, 6))
+	a.EqualNow([]int{1, 2, 3, 2, 4}, q.ToSlice())
+	a.NotTrueNow(q.AddAll(6))
+	a.TrueNow(q.Contains(3))
+	a.NotTrueNow(q.Contains(5))
+	a.TrueNow(q.ContainsAll(1, 4))
+	a.NotTrueNow(q.ContainsAll(1, 5))
+	a.EqualNow("queue[1 2 3 2 4]", q.String())
+
+	other := constructor(5)
+	other.AddAll(1, 2, 3, 2, 4)
+	a.TrueNow(q.Equals(other))
+	a.TrueNow(q.Equals(q))
+	other.Poll()
+	a.NotTrueNow(q.Equals(other))
+	a.NotTrueNow(q.Equals(NewArrayDequeFrom(1, 2, 3, 2, 4)))
+
+	res := make([]int, 0, q.Size())
+	a.NilNow(q.ForEach(func(e int) error {
+		res = append(res, e)
+		return nil
+	}))
+	a.EqualNow(q.ToSlice(), res)
+	expectedErr := errors.New("expected error")
+	a.EqualNow(expectedErr, q.ForEach(func(e int) error {
+		return expectedErr
+	}))
+
+	a.TrueNow(q.Remove(2))
+	a.NotTrueNow(q.Remove(2))
+	a.TrueNow(q.RemoveAll(1, 7))
+	a.TrueNow(q.RemoveIf(func(e int) bool { return e == 3 }))
+	a.EqualNow([]int{4}, q.ToSlice())
+	a.TrueNow(q.AddAll(5, 6))
+	a.TrueNow(q.RetainAll(5))
+	a.EqualNow([]int{5}, q.ToSlice())
+
+	q.Clear()
+	a.TrueNow(q.IsEmpty())
+	a.EqualNow(0, q.Size())
+}
+
+func testBlockingQueueJSON(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(3)
+	q.AddAll(1, 2, 3)
+
+	b, err := json.Marshal(q)
+	a.NilNow(err)
+	a.EqualNow("[1,2,3]", string(b))
+
+	q2 := constructor(3)
+	q2.Add(4)
+	a.NilNow(json.Unmarshal(b, q2))
+	a.EqualNow([]int{1, 2, 3}, q2.ToSlice())
+
+	q3 := constructor(2)
+	a.EqualNow(collection.ErrQueueFull, json.Unmarshal(b, q3))
+	a.NotNilNow(json.Unmarshal([]byte(`{}`), q3))
+}
+
+func testBlockingQueueConcurrent(a *assert.Assertion, constructor blockingQueueConstructor) {
+	q := constructor(4)
+	producers := 4
+	n := 100
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < producers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < n; j++ {
+				q.Put(j)
+			}
+		}()
+	}
+
+	sum := 0
+	for i := 0; i < producers*n; i++ {
+		sum += q.Take()
+	}
+	wg.Wait()
+
+	a.EqualNow(producers*n*(n-1)/2, sum)
+	a.TrueNow(q.IsEmpty())
+}
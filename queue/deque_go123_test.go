@@ -0,0 +1,32 @@
+//go:build go1.23
+
+package queue
+
+import (
+	"github.com/ghosind/go-assert"
+)
+
+func testDequeIter(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor(1, 2, 3)
+
+	res := make([]int, 0, d.Size())
+	for e := range d.Iter() {
+		res = append(res, e)
+	}
+	a.EqualNow([]int{1, 2, 3}, res)
+
+	res = res[:0]
+	for e := range d.DescendingIter() {
+		res = append(res, e)
+	}
+	a.EqualNow([]int{3, 2, 1}, res)
+
+	for range d.Iter() {
+		// yield should returns false
+		break
+	}
+	for range d.DescendingIter() {
+		// yield should returns false
+		break
+	}
+}
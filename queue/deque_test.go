@@ -0,0 +1,177 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type dequeConstructor func(...int) collection.Deque[int]
+
+func testDeque(a *assert.Assertion, constructor dequeConstructor) {
+	testDequeOfferPoll(a, constructor)
+	testDequePeek(a, constructor)
+	testDequeGrow(a, constructor)
+	testDequeCollection(a, constructor)
+	testDequeRemove(a, constructor)
+	testDequeForEach(a, constructor)
+	testDequeIter(a, constructor)
+	testDequeJSON(a, constructor)
+}
+
+func testDequeOfferPoll(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor()
+
+	_, ok := d.Poll()
+	a.NotTrueNow(ok)
+	_, ok = d.PollLast()
+	a.NotTrueNow(ok)
+
+	a.TrueNow(d.Offer(2))
+	a.TrueNow(d.OfferFirst(1))
+	a.TrueNow(d.OfferLast(3))
+	a.EqualNow([]int{1, 2, 3}, d.ToSlice())
+	a.EqualNow(3, d.Size())
+
+	e, ok := d.Poll()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	e, ok = d.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(3, e)
+	e, ok = d.PollFirst()
+	a.TrueNow(ok)
+	a.EqualNow(2, e)
+	a.TrueNow(d.IsEmpty())
+}
+
+func testDequePeek(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor()
+
+	_, ok := d.Peek()
+	a.NotTrueNow(ok)
+	_, ok = d.PeekFirst()
+	a.NotTrueNow(ok)
+	_, ok = d.PeekLast()
+	a.NotTrueNow(ok)
+
+	d = constructor(1, 2, 3)
+	e, ok := d.Peek()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	e, ok = d.PeekFirst()
+	a.TrueNow(ok)
+	a.EqualNow(1, e)
+	e, ok = d.PeekLast()
+	a.TrueNow(ok)
+	a.EqualNow(3, e)
+	a.EqualNow(3, d.Size())
+}
+
+func testDequeGrow(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor()
+	expected := make([]int, 0, 100)
+
+	for i := 0; i < 50; i++ {
+		d.OfferFirst(49 - i)
+		d.OfferLast(50 + i)
+	}
+	for i := 0; i < 100; i++ {
+		expected = append(expected, i)
+	}
+	a.EqualNow(expected, d.ToSlice())
+
+	// wraps around the end of the buffer
+	for i := 0; i < 30; i++ {
+		e, _ := d.Poll()
+		d.Offer(e)
+	}
+	a.EqualNow(append(expected[30:], expected[:30]...), d.ToSlice())
+}
+
+func testDequeCollection(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor()
+
+	a.NotTrueNow(d.AddAll())
+	a.TrueNow(d.Add(1))
+	a.TrueNow(d.AddAll(2, 3, 4))
+	a.TrueNow(d.Contains(3))
+	a.NotTrueNow(d.Contains(5))
+	a.TrueNow(d.ContainsAll(1, 4))
+	a.NotTrueNow(d.ContainsAll(1, 5))
+	a.EqualNow("deque[1 2 3 4]", d.(fmt.Stringer).String())
+
+	a.TrueNow(d.Equals(constructor(1, 2, 3, 4)))
+	a.NotTrueNow(d.Equals(constructor(1, 2, 3)))
+	a.NotTrueNow(d.Equals(constructor(1, 2, 3, 5)))
+	a.NotTrueNow(d.Equals([]int{1, 2, 3, 4}))
+
+	d.Clear()
+	a.TrueNow(d.IsEmpty())
+	a.EqualNow(0, d.Size())
+	a.EqualNow([]int{}, d.ToSlice())
+}
+
+func testDequeRemove(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor(1, 2, 3, 2, 4, 5, 6)
+
+	a.TrueNow(d.Remove(2))
+	a.NotTrueNow(d.Remove(2))
+	a.EqualNow([]int{1, 3, 4, 5, 6}, d.ToSlice())
+
+	a.NotTrueNow(d.RemoveAll())
+	a.TrueNow(d.RemoveAll(1, 7))
+	a.EqualNow([]int{3, 4, 5, 6}, d.ToSlice())
+
+	a.TrueNow(d.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	a.NotTrueNow(d.RemoveIf(func(e int) bool { return e%2 == 0 }))
+	a.EqualNow([]int{3, 5}, d.ToSlice())
+
+	a.TrueNow(d.RetainAll(5))
+	a.NotTrueNow(d.RetainAll(5))
+	a.EqualNow([]int{5}, d.ToSlice())
+
+	a.TrueNow(d.RetainAll())
+	a.TrueNow(d.IsEmpty())
+}
+
+func testDequeForEach(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor(1, 2, 3)
+	res := make([]int, 0, 3)
+
+	err := d.ForEach(func(e int) error {
+		res = append(res, e)
+		return nil
+	})
+	a.NilNow(err)
+	a.EqualNow([]int{1, 2, 3}, res)
+
+	expectedErr := errors.New("expected error")
+	err = d.ForEach(func(e int) error {
+		if e == 2 {
+			return expectedErr
+		}
+		return nil
+	})
+	a.EqualNow(expectedErr, err)
+}
+
+func testDequeJSON(a *assert.Assertion, constructor dequeConstructor) {
+	d := constructor()
+	d.OfferFirst(2)
+	d.OfferFirst(1)
+	d.OfferLast(3)
+
+	b, err := json.Marshal(d)
+	a.NilNow(err)
+	a.EqualNow("[1,2,3]", string(b))
+
+	d2 := constructor()
+	a.NilNow(json.Unmarshal(b, d2))
+	a.EqualNow([]int{1, 2, 3}, d2.ToSlice())
+
+	a.NotNilNow(json.Unmarshal([]byte(`{"a":1}`), d2))
+}
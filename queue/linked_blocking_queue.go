@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"math"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+)
+
+// LinkedBlockingQueue is an optionally bounded blocking queue based on linked list, it orders the
+// elements in the FIFO manner. The queue must be created by NewLinkedBlockingQueue or
+// NewBoundedLinkedBlockingQueue.
+type LinkedBlockingQueue[T any] struct {
+	blockingQueue[T]
+}
+
+// NewLinkedBlockingQueue creates a new unbounded LinkedBlockingQueue.
+func NewLinkedBlockingQueue[T any]() *LinkedBlockingQueue[T] {
+	return NewBoundedLinkedBlockingQueue[T](math.MaxInt)
+}
+
+// NewBoundedLinkedBlockingQueue creates a new LinkedBlockingQueue with the specified capacity. It
+// panics if the capacity is not positive.
+func NewBoundedLinkedBlockingQueue[T any](capacity int) *LinkedBlockingQueue[T] {
+	if capacity <= 0 {
+		panic(collection.ErrInvalidCapacity)
+	}
+
+	q := new(LinkedBlockingQueue[T])
+	q.data = list.NewLinkedList[T]()
+	q.capacity = capacity
+
+	return q
+}
+
+// Capacity returns the capacity of this queue, it is math.MaxInt for the unbounded queue.
+func (q *LinkedBlockingQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+// Clone returns a copy of this queue with the same capacity.
+func (q *LinkedBlockingQueue[T]) Clone() collection.BlockingQueue[T] {
+	newQueue := NewBoundedLinkedBlockingQueue[T](q.capacity)
+	newQueue.AddAll(q.ToSlice()...)
+
+	return newQueue
+}
+
+// Equals compares this queue with the object pass from parameter.
+func (q *LinkedBlockingQueue[T]) Equals(o any) bool {
+	oq, ok := o.(*LinkedBlockingQueue[T])
+	if !ok {
+		return false
+	}
+
+	return q.equals(&oq.blockingQueue)
+}
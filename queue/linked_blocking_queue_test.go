@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func linkedBlockingQueueConstructor(capacity int) collection.BlockingQueue[int] {
+	return NewBoundedLinkedBlockingQueue[int](capacity)
+}
+
+func TestLinkedBlockingQueue(t *testing.T) {
+	a := assert.New(t)
+
+	testBlockingQueue(a, linkedBlockingQueueConstructor)
+}
+
+func TestLinkedBlockingQueueCapacity(t *testing.T) {
+	a := assert.New(t)
+
+	a.PanicOfNow(func() {
+		NewBoundedLinkedBlockingQueue[int](-1)
+	}, collection.ErrInvalidCapacity)
+
+	q := NewLinkedBlockingQueue[int]()
+	a.EqualNow(math.MaxInt, q.Capacity())
+	for i := 0; i < 1000; i++ {
+		a.TrueNow(q.Offer(i))
+	}
+	a.EqualNow(1000, q.Size())
+
+	clone := q.Clone()
+	a.TrueNow(q.Equals(clone))
+	a.NotTrueNow(q.Equals(NewArrayBlockingQueue[int](1000)))
+}
@@ -0,0 +1,420 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// PriorityQueueItem is the handle of an element in the PriorityQueue, it can be used to update or
+// remove the element after it has been added to the queue.
+type PriorityQueueItem[T any] struct {
+	// Value is the element of the item. If the value has been changed directly, PriorityQueue.Fix
+	// must be called to re-establish the ordering.
+	Value T
+	index int
+}
+
+// PriorityQueue is an unbounded queue based on binary heap, the head of the queue is the least
+// element with respect to the less function.
+type PriorityQueue[T any] struct {
+	items []*PriorityQueueItem[T]
+	less  func(a, b T) bool
+}
+
+// NewPriorityQueue creates a new empty PriorityQueue with the specified less function. The less
+// function returns true if a must be polled before b.
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	q := new(PriorityQueue[T])
+	q.less = less
+
+	return q
+}
+
+// NewPriorityQueueFrom creates and returns a new PriorityQueue with the specified less function
+// containing the elements of the provided collection. The heap is built in linear time.
+func NewPriorityQueueFrom[T any](less func(a, b T) bool, c ...T) *PriorityQueue[T] {
+	q := NewPriorityQueue(less)
+	q.items = make([]*PriorityQueueItem[T], len(c))
+	for i, e := range c {
+		q.items[i] = &PriorityQueueItem[T]{Value: e, index: i}
+	}
+	q.heapify()
+
+	return q
+}
+
+// Add adds the specified element to this queue.
+func (q *PriorityQueue[T]) Add(e T) bool {
+	q.Push(e)
+	return true
+}
+
+// AddAll adds all of the specified elements to this queue.
+func (q *PriorityQueue[T]) AddAll(c ...T) bool {
+	for _, e := range c {
+		q.Push(e)
+	}
+
+	return len(c) > 0
+}
+
+// Clear removes all of the elements from this queue.
+func (q *PriorityQueue[T]) Clear() {
+	for _, item := range q.items {
+		item.index = -1
+	}
+	q.items = nil
+}
+
+// Clone returns a copy of this queue, the handles of the copy are different from this queue.
+func (q *PriorityQueue[T]) Clone() collection.Queue[T] {
+	newQueue := NewPriorityQueue(q.less)
+	newQueue.items = make([]*PriorityQueueItem[T], len(q.items))
+	for i, item := range q.items {
+		newQueue.items[i] = &PriorityQueueItem[T]{Value: item.Value, index: i}
+	}
+
+	return newQueue
+}
+
+// Contains returns true if this queue contains the specified element.
+func (q *PriorityQueue[T]) Contains(e T) bool {
+	for _, item := range q.items {
+		if internal.Equal(item.Value, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainsAll returns true if this queue contains all of the specified elements.
+func (q *PriorityQueue[T]) ContainsAll(c ...T) bool {
+	slice := q.ToSlice()
+	cache := internal.MakeSliceCacheMap(slice)
+	defer internal.ReleaseCacheMap(cache)
+
+	for _, e := range c {
+		if !internal.InSlice(e, slice, cache) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Drain removes all of the elements from this queue, and returns them in priority order.
+func (q *PriorityQueue[T]) Drain() []T {
+	slice := make([]T, 0, len(q.items))
+	for len(q.items) > 0 {
+		slice = append(slice, q.removeAt(0).Value)
+	}
+
+	return slice
+}
+
+// Equals compares this queue with the object pass from parameter. Two queues are equal if they
+// contain the same elements in the same priority order.
+func (q *PriorityQueue[T]) Equals(o any) bool {
+	oq, ok := o.(*PriorityQueue[T])
+	if !ok {
+		return false
+	}
+
+	if q.Size() != oq.Size() {
+		return false
+	}
+
+	s1 := q.sortedSlice()
+	s2 := oq.sortedSlice()
+	for i := range s1 {
+		if !internal.Equal(s1[i], s2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fix re-establishes the heap ordering after the value of the item has been changed. It returns
+// false if the item is not in this queue.
+func (q *PriorityQueue[T]) Fix(item *PriorityQueueItem[T]) bool {
+	if !q.owns(item) {
+		return false
+	}
+
+	if !q.down(item.index) {
+		q.up(item.index)
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each element in this queue in no particular order until
+// all elements have been processed or the handler returns an error.
+func (q *PriorityQueue[T]) ForEach(handler func(e T) error) error {
+	for _, e := range q.ToSlice() {
+		if err := handler(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if this queue contains no elements.
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// Offer inserts the specified element into this queue.
+func (q *PriorityQueue[T]) Offer(e T) bool {
+	q.Push(e)
+	return true
+}
+
+// Peek returns the head of this queue without removing it, or false if this queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return q.items[0].Value, true
+}
+
+// Poll removes and returns the head of this queue, or false if this queue is empty.
+func (q *PriorityQueue[T]) Poll() (T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return q.removeAt(0).Value, true
+}
+
+// Push inserts the specified element into this queue, and returns the handle of the element.
+func (q *PriorityQueue[T]) Push(e T) *PriorityQueueItem[T] {
+	item := &PriorityQueueItem[T]{Value: e, index: len(q.items)}
+	q.items = append(q.items, item)
+	q.up(item.index)
+
+	return item
+}
+
+// Remove removes all occurrences of the specified element from this queue.
+func (q *PriorityQueue[T]) Remove(e T) bool {
+	return q.RemoveIf(func(v T) bool {
+		return internal.Equal(v, e)
+	})
+}
+
+// RemoveAll removes all occurrences of the specified elements from this queue.
+func (q *PriorityQueue[T]) RemoveAll(c ...T) bool {
+	if len(c) == 0 {
+		return false
+	}
+
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return q.RemoveIf(func(v T) bool {
+		return internal.InSlice(v, c, cache)
+	})
+}
+
+// RemoveIf removes all of the elements of this queue that satisfy the given predicate.
+func (q *PriorityQueue[T]) RemoveIf(f func(T) bool) bool {
+	n := 0
+	for _, item := range q.items {
+		if f(item.Value) {
+			item.index = -1
+			continue
+		}
+		item.index = n
+		q.items[n] = item
+		n++
+	}
+
+	if n == len(q.items) {
+		return false
+	}
+
+	for i := n; i < len(q.items); i++ {
+		q.items[i] = nil
+	}
+	q.items = q.items[:n]
+	q.heapify()
+
+	return true
+}
+
+// RemoveItem removes the element of the specified handle from this queue. It returns false if the
+// item is not in this queue.
+func (q *PriorityQueue[T]) RemoveItem(item *PriorityQueueItem[T]) bool {
+	if !q.owns(item) {
+		return false
+	}
+
+	q.removeAt(item.index)
+	return true
+}
+
+// RetainAll retains only the elements in this queue that are contained in the specified elements.
+func (q *PriorityQueue[T]) RetainAll(c ...T) bool {
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return q.RemoveIf(func(v T) bool {
+		return !internal.InSlice(v, c, cache)
+	})
+}
+
+// Size returns the number of elements in this queue.
+func (q *PriorityQueue[T]) Size() int {
+	return len(q.items)
+}
+
+// String returns the string representation of this queue, the elements are in priority order.
+func (q *PriorityQueue[T]) String() string {
+	buf := bytes.NewBufferString("queue[")
+	for i, e := range q.sortedSlice() {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(e))
+	}
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// ToSlice returns a slice containing all of the elements in this queue in no particular order.
+func (q *PriorityQueue[T]) ToSlice() []T {
+	slice := make([]T, len(q.items))
+	for i, item := range q.items {
+		slice[i] = item.Value
+	}
+
+	return slice
+}
+
+// Update sets the value of the item and re-establishes the heap ordering. It returns false if the
+// item is not in this queue.
+func (q *PriorityQueue[T]) Update(item *PriorityQueueItem[T], e T) bool {
+	if !q.owns(item) {
+		return false
+	}
+
+	item.Value = e
+	return q.Fix(item)
+}
+
+// MarshalJSON marshals the queue as a JSON array in priority order.
+func (q *PriorityQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.sortedSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the queue. The PriorityQueue must be created with a
+// less function before unmarshaling.
+func (q *PriorityQueue[T]) UnmarshalJSON(b []byte) error {
+	if q.less == nil {
+		return collection.ErrNoComparator
+	}
+
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	*q = *NewPriorityQueueFrom(q.less, items...)
+	return nil
+}
+
+// owns returns true if the item is in this queue.
+func (q *PriorityQueue[T]) owns(item *PriorityQueueItem[T]) bool {
+	return item != nil && item.index >= 0 && item.index < len(q.items) && q.items[item.index] == item
+}
+
+// sortedSlice returns a slice containing all of the elements in this queue in priority order.
+func (q *PriorityQueue[T]) sortedSlice() []T {
+	slice := q.ToSlice()
+	sort.SliceStable(slice, func(i, j int) bool {
+		return q.less(slice[i], slice[j])
+	})
+
+	return slice
+}
+
+// heapify establishes the heap ordering of all items.
+func (q *PriorityQueue[T]) heapify() {
+	for i := len(q.items)/2 - 1; i >= 0; i-- {
+		q.down(i)
+	}
+}
+
+// removeAt removes and returns the item at the specified position of the heap.
+func (q *PriorityQueue[T]) removeAt(i int) *PriorityQueueItem[T] {
+	last := len(q.items) - 1
+	if i != last {
+		q.swap(i, last)
+	}
+
+	item := q.items[last]
+	q.items[last] = nil
+	q.items = q.items[:last]
+	item.index = -1
+
+	if i != last {
+		if !q.down(i) {
+			q.up(i)
+		}
+	}
+
+	return item
+}
+
+// up moves the item at the specified position up to its proper position.
+func (q *PriorityQueue[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.items[i].Value, q.items[parent].Value) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at the specified position down to its proper position, and returns true if
+// the item was moved.
+func (q *PriorityQueue[T]) down(i int) bool {
+	start := i
+	n := len(q.items)
+	for {
+		child := 2*i + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && q.less(q.items[right].Value, q.items[child].Value) {
+			child = right
+		}
+		if !q.less(q.items[child].Value, q.items[i].Value) {
+			break
+		}
+		q.swap(i, child)
+		i = child
+	}
+
+	return i > start
+}
+
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
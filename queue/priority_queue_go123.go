@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package queue
+
+import "iter"
+
+// Iter returns an iterator of all elements in this queue in no particular order.
+func (q *PriorityQueue[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.items {
+			if !yield(item.Value) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,27 @@
+//go:build go1.23
+
+package queue
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestPriorityQueueIter(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueueFrom(intLess, 3, 1, 2)
+
+	res := make([]int, 0, q.Size())
+	for e := range q.Iter() {
+		res = append(res, e)
+	}
+	sort.Ints(res)
+	a.EqualNow([]int{1, 2, 3}, res)
+
+	for range q.Iter() {
+		// yield should returns false
+		break
+	}
+}
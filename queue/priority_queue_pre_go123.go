@@ -0,0 +1,15 @@
+//go:build !go1.23
+
+package queue
+
+// Iter returns a channel of all elements in this queue in no particular order.
+func (q *PriorityQueue[T]) Iter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for _, item := range q.items {
+			ch <- item.Value
+		}
+		close(ch)
+	}()
+	return ch
+}
@@ -0,0 +1,170 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestPriorityQueue(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueue(intLess)
+
+	_, ok := q.Peek()
+	a.NotTrueNow(ok)
+	_, ok = q.Poll()
+	a.NotTrueNow(ok)
+
+	nums := rand.Perm(100)
+	for _, n := range nums {
+		a.TrueNow(q.Offer(n))
+	}
+	a.EqualNow(100, q.Size())
+
+	e, ok := q.Peek()
+	a.TrueNow(ok)
+	a.EqualNow(0, e)
+
+	for i := 0; i < 100; i++ {
+		e, ok := q.Poll()
+		a.TrueNow(ok)
+		a.EqualNow(i, e)
+	}
+	a.TrueNow(q.IsEmpty())
+}
+
+func TestPriorityQueueFrom(t *testing.T) {
+	a := assert.New(t)
+	nums := rand.Perm(100)
+	q := NewPriorityQueueFrom(intLess, nums...)
+
+	a.EqualNow(100, q.Size())
+	sort.Ints(nums)
+	a.EqualNow(nums, q.Drain())
+	a.TrueNow(q.IsEmpty())
+	a.EqualNow([]int{}, q.Drain())
+}
+
+func TestPriorityQueueHandle(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueue(intLess)
+
+	items := make([]*PriorityQueueItem[int], 0, 10)
+	for i := 0; i < 10; i++ {
+		items = append(items, q.Push(i*10))
+	}
+
+	a.TrueNow(q.Update(items[9], -1))
+	e, _ := q.Peek()
+	a.EqualNow(-1, e)
+
+	items[0].Value = 100
+	a.TrueNow(q.Fix(items[0]))
+	a.TrueNow(q.RemoveItem(items[5]))
+	a.NotTrueNow(q.RemoveItem(items[5]))
+	a.NotTrueNow(q.Update(items[5], 0))
+	a.NotTrueNow(q.Fix(items[5]))
+	a.NotTrueNow(q.Fix(nil))
+
+	other := NewPriorityQueueFrom(intLess, 1, 2, 3)
+	a.NotTrueNow(other.RemoveItem(items[1]))
+
+	a.EqualNow([]int{-1, 10, 20, 30, 40, 60, 70, 80, 100}, q.Drain())
+	a.NotTrueNow(q.Fix(items[1]))
+}
+
+func TestPriorityQueueCollection(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueue(intLess)
+
+	a.NotTrueNow(q.AddAll())
+	a.TrueNow(q.Add(3))
+	a.TrueNow(q.AddAll(5, 1, 4, 1))
+	a.TrueNow(q.Contains(4))
+	a.NotTrueNow(q.Contains(2))
+	a.TrueNow(q.ContainsAll(1, 5))
+	a.NotTrueNow(q.ContainsAll(1, 2))
+	a.EqualNow("queue[1 1 3 4 5]", q.String())
+
+	slice := q.ToSlice()
+	sort.Ints(slice)
+	a.EqualNow([]int{1, 1, 3, 4, 5}, slice)
+
+	clone := q.Clone()
+	a.TrueNow(q.Equals(clone))
+	clone.Poll()
+	a.NotTrueNow(q.Equals(clone))
+	a.NotTrueNow(q.Equals(NewPriorityQueueFrom(intLess, 1, 2, 3, 4, 5)))
+	a.NotTrueNow(q.Equals(NewArrayDequeFrom(1, 1, 3, 4, 5)))
+
+	a.TrueNow(q.Remove(1))
+	a.NotTrueNow(q.Remove(1))
+	a.NotTrueNow(q.RemoveAll())
+	a.TrueNow(q.RemoveAll(4, 6))
+	a.EqualNow("queue[3 5]", q.String())
+	a.TrueNow(q.RetainAll(5))
+	a.NotTrueNow(q.RetainAll(5))
+	a.EqualNow([]int{5}, q.ToSlice())
+
+	q.AddAll(9, 7, 8)
+	a.TrueNow(q.RemoveIf(func(e int) bool { return e > 7 }))
+	a.EqualNow([]int{5, 7}, q.Drain())
+
+	q.AddAll(1, 2)
+	q.Clear()
+	a.TrueNow(q.IsEmpty())
+}
+
+func TestPriorityQueueForEach(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueueFrom(intLess, 3, 1, 2)
+
+	sum := 0
+	a.NilNow(q.ForEach(func(e int) error {
+		sum += e
+		return nil
+	}))
+	a.EqualNow(6, sum)
+
+	expectedErr := errors.New("expected error")
+	a.EqualNow(expectedErr, q.ForEach(func(e int) error {
+		return expectedErr
+	}))
+}
+
+func TestPriorityQueueJSON(t *testing.T) {
+	a := assert.New(t)
+	q := NewPriorityQueueFrom(func(a, b int) bool { return a > b }, 1, 3, 2)
+
+	b, err := json.Marshal(q)
+	a.NilNow(err)
+	a.EqualNow("[3,2,1]", string(b))
+
+	q2 := NewPriorityQueue(intLess)
+	a.NilNow(json.Unmarshal(b, q2))
+	a.EqualNow([]int{1, 2, 3}, q2.Drain())
+	a.NotNilNow(json.Unmarshal([]byte(`{}`), q2))
+
+	var zero PriorityQueue[int]
+	a.EqualNow(collection.ErrNoComparator, zero.UnmarshalJSON(b))
+}
+
+func BenchmarkPriorityQueue_OfferPoll(b *testing.B) {
+	q := NewPriorityQueue(intLess)
+
+	for i := 0; i < b.N; i++ {
+		q.Offer(rand.Int())
+		if i%2 == 1 {
+			q.Poll()
+		}
+	}
+}
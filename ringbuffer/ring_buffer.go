@@ -0,0 +1,502 @@
+package ringbuffer
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// OverflowPolicy decides how the RingBuffer handles a new element when it is full.
+type OverflowPolicy int
+
+const (
+	// OverwriteOldest discards the oldest element to make room for the new element.
+	OverwriteOldest OverflowPolicy = iota
+	// RejectNew discards the new element, the adding methods return false.
+	RejectNew
+	// PanicOnOverflow panics with ErrBufferFull.
+	PanicOnOverflow
+)
+
+// RingBuffer is a fixed capacity sequenced collection based on circular array. The elements are
+// indexed from the oldest one, and the new elements are added after the newest one. It implements
+// the List interface, so it can be wrapped by list.LockList for thread safety. The buffer must be
+// created by NewRingBuffer or NewRingBufferFrom.
+type RingBuffer[T any] struct {
+	elements []T
+	head     int
+	size     int
+	policy   OverflowPolicy
+}
+
+// NewRingBuffer creates a new empty RingBuffer with the specified capacity and overflow policy. It
+// panics if the capacity is not positive.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic(collection.ErrInvalidCapacity)
+	}
+
+	rb := new(RingBuffer[T])
+	rb.elements = make([]T, capacity)
+	rb.policy = policy
+
+	return rb
+}
+
+// NewRingBufferFrom creates and returns a new RingBuffer with the specified capacity and overflow
+// policy, and adds the elements of the provided collection in order.
+func NewRingBufferFrom[T any](capacity int, policy OverflowPolicy, c ...T) *RingBuffer[T] {
+	rb := NewRingBuffer[T](capacity, policy)
+	rb.AddAll(c...)
+
+	return rb
+}
+
+// Add adds the specified element after the newest element. If this buffer is full, it is handled
+// by the overflow policy, and returns false if the element was rejected.
+func (rb *RingBuffer[T]) Add(e T) bool {
+	if rb.IsFull() && !rb.overflow() {
+		return false
+	}
+
+	rb.elements[rb.index(rb.size)] = e
+	rb.size++
+
+	return true
+}
+
+// AddAll adds all of the specified elements in order. It returns true if any element was added.
+func (rb *RingBuffer[T]) AddAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if rb.Add(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// AddAtIndex inserts the specified element to the specified position in this buffer. If this
+// buffer is full, the overflow policy is applied after the insertion with the OverwriteOldest
+// policy, or the element is discarded with the RejectNew policy.
+func (rb *RingBuffer[T]) AddAtIndex(i int, e T) {
+	internal.CheckIndex(i, rb.size+1)
+
+	if rb.IsFull() {
+		if rb.policy == OverwriteOldest && i == 0 {
+			// the new element would be the oldest one, so it is discarded immediately.
+			return
+		}
+		if !rb.overflow() {
+			return
+		}
+		i--
+	}
+
+	for j := rb.size; j > i; j-- {
+		rb.elements[rb.index(j)] = rb.elements[rb.index(j-1)]
+	}
+	rb.elements[rb.index(i)] = e
+	rb.size++
+}
+
+// Capacity returns the capacity of this buffer.
+func (rb *RingBuffer[T]) Capacity() int {
+	return len(rb.elements)
+}
+
+// Clear removes all of the elements from this buffer.
+func (rb *RingBuffer[T]) Clear() {
+	var zero T
+	for i := 0; i < rb.size; i++ {
+		rb.elements[rb.index(i)] = zero
+	}
+
+	rb.head = 0
+	rb.size = 0
+}
+
+// Clone returns a copy of this buffer with the same capacity and overflow policy.
+func (rb *RingBuffer[T]) Clone() collection.List[T] {
+	return NewRingBufferFrom(rb.Capacity(), rb.policy, rb.ToSlice()...)
+}
+
+// Contains returns true if this buffer contains the specified element.
+func (rb *RingBuffer[T]) Contains(e T) bool {
+	return rb.IndexOf(e) >= 0
+}
+
+// ContainsAll returns true if this buffer contains all of the specified elements.
+func (rb *RingBuffer[T]) ContainsAll(c ...T) bool {
+	slice := rb.ToSlice()
+	cache := internal.MakeSliceCacheMap(slice)
+	defer internal.ReleaseCacheMap(cache)
+
+	for _, e := range c {
+		if !internal.InSlice(e, slice, cache) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals compares this buffer with the object pass from parameter. Two buffers are equal if they
+// contain the same elements in the same order.
+func (rb *RingBuffer[T]) Equals(o any) bool {
+	orb, ok := o.(*RingBuffer[T])
+	if !ok {
+		return false
+	}
+
+	if rb.size != orb.size {
+		return false
+	}
+
+	for i := 0; i < rb.size; i++ {
+		if !internal.Equal(rb.elements[rb.index(i)], orb.elements[orb.index(i)]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each element from the oldest to the newest until all
+// elements have been processed or the handler returns an error.
+func (rb *RingBuffer[T]) ForEach(handler func(e T) error) error {
+	for i := 0; i < rb.size; i++ {
+		if err := handler(rb.elements[rb.index(i)]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get returns the element at the specified position, the position 0 is the oldest element.
+func (rb *RingBuffer[T]) Get(i int) T {
+	internal.CheckIndex(i, rb.size)
+
+	return rb.elements[rb.index(i)]
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in this buffer, or -1
+// if this buffer does not contain the element.
+func (rb *RingBuffer[T]) IndexOf(e T) int {
+	for i := 0; i < rb.size; i++ {
+		if internal.Equal(rb.elements[rb.index(i)], e) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IsEmpty returns true if this buffer contains no elements.
+func (rb *RingBuffer[T]) IsEmpty() bool {
+	return rb.size == 0
+}
+
+// IsFull returns true if the number of elements in this buffer reaches its capacity.
+func (rb *RingBuffer[T]) IsFull() bool {
+	return rb.size == len(rb.elements)
+}
+
+// LastIndexOf returns the index of the last occurrence of the specified element in this buffer, or
+// -1 if this buffer does not contain the element.
+func (rb *RingBuffer[T]) LastIndexOf(e T) int {
+	for i := rb.size - 1; i >= 0; i-- {
+		if internal.Equal(rb.elements[rb.index(i)], e) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Policy returns the overflow policy of this buffer.
+func (rb *RingBuffer[T]) Policy() OverflowPolicy {
+	return rb.policy
+}
+
+// Remove removes all occurrences of the specified element from this buffer.
+func (rb *RingBuffer[T]) Remove(e T) bool {
+	return rb.RemoveIf(func(v T) bool {
+		return internal.Equal(v, e)
+	})
+}
+
+// RemoveAll removes all occurrences of the specified elements from this buffer.
+func (rb *RingBuffer[T]) RemoveAll(c ...T) bool {
+	if len(c) == 0 {
+		return false
+	}
+
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return rb.RemoveIf(func(v T) bool {
+		return internal.InSlice(v, c, cache)
+	})
+}
+
+// RemoveAtIndex removes the element at the specified position in this buffer.
+func (rb *RingBuffer[T]) RemoveAtIndex(i int) T {
+	internal.CheckIndex(i, rb.size)
+
+	e := rb.elements[rb.index(i)]
+	rb.compact(func(j int, _ T) bool {
+		return j == i
+	})
+
+	return e
+}
+
+// RemoveFirst removes the first occurrence of the specified element from this buffer. Returns true
+// if the element was removed.
+func (rb *RingBuffer[T]) RemoveFirst(e T) bool {
+	return rb.RemoveFirstN(e, 1) > 0
+}
+
+// RemoveFirstN removes the first n occurrences of the specified element from this buffer. Returns
+// the number of elements removed.
+func (rb *RingBuffer[T]) RemoveFirstN(e T, n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	removed := 0
+	rb.compact(func(_ int, v T) bool {
+		if removed < n && internal.Equal(v, e) {
+			removed++
+			return true
+		}
+		return false
+	})
+
+	return removed
+}
+
+// RemoveIf removes all of the elements of this buffer that satisfy the given predicate.
+func (rb *RingBuffer[T]) RemoveIf(f func(T) bool) bool {
+	return rb.compact(func(_ int, v T) bool {
+		return f(v)
+	}) > 0
+}
+
+// RemoveLast removes the last occurrence of the specified element from this buffer. Returns true
+// if the element was removed.
+func (rb *RingBuffer[T]) RemoveLast(e T) bool {
+	return rb.RemoveLastN(e, 1) > 0
+}
+
+// RemoveLastN removes the last n occurrences of the specified element from this buffer. Returns
+// the number of elements removed.
+func (rb *RingBuffer[T]) RemoveLastN(e T, n int) int {
+	if n <= 0 {
+		return 0
+	}
+
+	indexes := make(map[int]struct{}, n)
+	for i := rb.size - 1; i >= 0 && len(indexes) < n; i-- {
+		if internal.Equal(rb.elements[rb.index(i)], e) {
+			indexes[i] = struct{}{}
+		}
+	}
+
+	return rb.compact(func(i int, _ T) bool {
+		_, found := indexes[i]
+		return found
+	})
+}
+
+// RetainAll retains only the elements in this buffer that are contained in the specified elements.
+func (rb *RingBuffer[T]) RetainAll(c ...T) bool {
+	cache := internal.MakeSliceCacheMap(c)
+	defer internal.ReleaseCacheMap(cache)
+
+	return rb.RemoveIf(func(v T) bool {
+		return !internal.InSlice(v, c, cache)
+	})
+}
+
+// Set replaces the element at the specified position in this buffer with the specified element.
+// If the position equals to the size of this buffer, the element is added like Add.
+func (rb *RingBuffer[T]) Set(i int, e T) T {
+	internal.CheckIndex(i, rb.size+1)
+
+	if i == rb.size {
+		rb.Add(e)
+		var zero T
+		return zero
+	}
+
+	j := rb.index(i)
+	old := rb.elements[j]
+	rb.elements[j] = e
+
+	return old
+}
+
+// Size returns the number of elements in this buffer.
+func (rb *RingBuffer[T]) Size() int {
+	return rb.size
+}
+
+// String returns the string representation of this buffer.
+func (rb *RingBuffer[T]) String() string {
+	buf := bytes.NewBufferString("ringbuffer[")
+	for i := 0; i < rb.size; i++ {
+		if i > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(rb.elements[rb.index(i)]))
+	}
+	buf.WriteString("]")
+
+	return buf.String()
+}
+
+// SubList returns a new buffer with the same capacity and overflow policy containing the portion
+// of this buffer between the specified fromIndex, inclusive, and toIndex, exclusive. It panics with
+// ErrOutOfBounds if either index is out of range or fromIndex is greater than toIndex.
+func (rb *RingBuffer[T]) SubList(fromIndex, toIndex int) collection.List[T] {
+	internal.CheckIndex(fromIndex, rb.size+1)
+	internal.CheckIndex(toIndex, rb.size+1)
+	if fromIndex > toIndex {
+		panic(collection.ErrOutOfBounds)
+	}
+
+	sub := NewRingBuffer[T](rb.Capacity(), rb.policy)
+	for i := fromIndex; i < toIndex; i++ {
+		sub.Add(rb.elements[rb.index(i)])
+	}
+
+	return sub
+}
+
+// ToSlice returns a slice containing all of the elements in this buffer from the oldest to the
+// newest.
+func (rb *RingBuffer[T]) ToSlice() []T {
+	slice := make([]T, rb.size)
+	for i := 0; i < rb.size; i++ {
+		slice[i] = rb.elements[rb.index(i)]
+	}
+
+	return slice
+}
+
+// Trim removes the oldest n elements from this buffer. Returns the number of elements removed.
+func (rb *RingBuffer[T]) Trim(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n > rb.size {
+		n = rb.size
+	}
+
+	var zero T
+	for i := 0; i < n; i++ {
+		rb.elements[rb.head] = zero
+		rb.head = (rb.head + 1) % len(rb.elements)
+	}
+	rb.size -= n
+
+	return n
+}
+
+// TrimLast removes the newest n elements from this buffer. Returns the number of elements removed.
+func (rb *RingBuffer[T]) TrimLast(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n > rb.size {
+		n = rb.size
+	}
+
+	var zero T
+	for i := rb.size - n; i < rb.size; i++ {
+		rb.elements[rb.index(i)] = zero
+	}
+	rb.size -= n
+
+	return n
+}
+
+// MarshalJSON marshals the buffer as a JSON array from the oldest element to the newest.
+func (rb *RingBuffer[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rb.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the buffer, it replaces all of the elements in this
+// buffer and the elements are added in order with the overflow policy. A zero value RingBuffer
+// takes the length of the array as its capacity.
+func (rb *RingBuffer[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	if rb.elements == nil {
+		if len(items) == 0 {
+			return collection.ErrInvalidCapacity
+		}
+		rb.elements = make([]T, len(items))
+	}
+
+	if rb.policy == PanicOnOverflow && len(items) > len(rb.elements) {
+		return collection.ErrBufferFull
+	}
+
+	rb.Clear()
+	rb.AddAll(items...)
+
+	return nil
+}
+
+// index returns the position in the underlying slice of the i-th element in this buffer.
+func (rb *RingBuffer[T]) index(i int) int {
+	return (rb.head + i) % len(rb.elements)
+}
+
+// overflow applies the overflow policy to the full buffer, and returns true if there is space for
+// the new element.
+func (rb *RingBuffer[T]) overflow() bool {
+	switch rb.policy {
+	case RejectNew:
+		return false
+	case PanicOnOverflow:
+		panic(collection.ErrBufferFull)
+	default:
+		rb.Trim(1)
+		return true
+	}
+}
+
+// compact removes the elements that satisfy the predicate and keeps the order of the others, and
+// returns the number of elements removed.
+func (rb *RingBuffer[T]) compact(remove func(i int, e T) bool) int {
+	n := 0
+	for i := 0; i < rb.size; i++ {
+		e := rb.elements[rb.index(i)]
+		if !remove(i, e) {
+			rb.elements[rb.index(n)] = e
+			n++
+		}
+	}
+
+	var zero T
+	for i := n; i < rb.size; i++ {
+		rb.elements[rb.index(i)] = zero
+	}
+
+	removed := rb.size - n
+	rb.size = n
+
+	return removed
+}
@@ -0,0 +1,16 @@
+//go:build go1.23
+
+package ringbuffer
+
+import "iter"
+
+// Iter returns an iterator of all elements in this buffer from the oldest to the newest.
+func (rb *RingBuffer[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < rb.size; i++ {
+			if !yield(rb.elements[rb.index(i)]) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+//go:build go1.23
+
+package ringbuffer
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestRingBufferIter(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBufferFrom(3, OverwriteOldest, 1, 2, 3, 4)
+
+	res := make([]int, 0, rb.Size())
+	for e := range rb.Iter() {
+		res = append(res, e)
+	}
+	a.EqualNow([]int{2, 3, 4}, res)
+
+	for range rb.Iter() {
+		// yield should returns false
+		break
+	}
+}
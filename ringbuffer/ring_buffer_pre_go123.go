@@ -0,0 +1,15 @@
+//go:build !go1.23
+
+package ringbuffer
+
+// Iter returns a channel of all elements in this buffer from the oldest to the newest.
+func (rb *RingBuffer[T]) Iter() <-chan T {
+	ch := make(chan T)
+	go func() {
+		for i := 0; i < rb.size; i++ {
+			ch <- rb.elements[rb.index(i)]
+		}
+		close(ch)
+	}()
+	return ch
+}
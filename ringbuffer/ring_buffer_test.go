@@ -0,0 +1,208 @@
+package ringbuffer
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/go-assert"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBuffer[int](3, OverwriteOldest)
+
+	a.TrueNow(rb.IsEmpty())
+	a.EqualNow(3, rb.Capacity())
+	a.EqualNow(OverwriteOldest, rb.Policy())
+
+	for i := 1; i <= 5; i++ {
+		a.TrueNow(rb.Add(i))
+	}
+	a.TrueNow(rb.IsFull())
+	a.EqualNow(3, rb.Size())
+	a.EqualNow([]int{3, 4, 5}, rb.ToSlice())
+	a.EqualNow(3, rb.Get(0))
+	a.EqualNow(5, rb.Get(2))
+	a.PanicOfNow(func() {
+		rb.Get(3)
+	}, collection.ErrOutOfBounds)
+
+	rb.AddAtIndex(0, 0)
+	a.EqualNow([]int{3, 4, 5}, rb.ToSlice())
+	rb.AddAtIndex(2, 6)
+	a.EqualNow([]int{4, 6, 5}, rb.ToSlice())
+	rb.AddAtIndex(3, 7)
+	a.EqualNow([]int{6, 5, 7}, rb.ToSlice())
+}
+
+func TestRingBufferReject(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBufferFrom(3, RejectNew, 1, 2)
+
+	a.TrueNow(rb.AddAll(3, 4))
+	a.NotTrueNow(rb.Add(5))
+	a.NotTrueNow(rb.AddAll(6, 7))
+	rb.AddAtIndex(0, 0)
+	a.EqualNow([]int{1, 2, 3}, rb.ToSlice())
+
+	rb.Set(3, 8)
+	a.EqualNow([]int{1, 2, 3}, rb.ToSlice())
+}
+
+func TestRingBufferPanic(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBufferFrom(2, PanicOnOverflow, 1, 2)
+
+	a.PanicOfNow(func() {
+		rb.Add(3)
+	}, collection.ErrBufferFull)
+	a.PanicOfNow(func() {
+		rb.AddAtIndex(0, 3)
+	}, collection.ErrBufferFull)
+	a.EqualNow([]int{1, 2}, rb.ToSlice())
+
+	a.PanicOfNow(func() {
+		NewRingBuffer[int](0, PanicOnOverflow)
+	}, collection.ErrInvalidCapacity)
+}
+
+func TestRingBufferSequenced(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBuffer[int](8, OverwriteOldest)
+	// moves the head to the middle of the underlying array
+	rb.AddAll(0, 0, 0, 0, 0)
+	rb.Trim(5)
+
+	rb.AddAll(1, 2, 3, 2, 1)
+	a.EqualNow("ringbuffer[1 2 3 2 1]", rb.String())
+	a.TrueNow(rb.Contains(3))
+	a.NotTrueNow(rb.Contains(4))
+	a.TrueNow(rb.ContainsAll(1, 3))
+	a.NotTrueNow(rb.ContainsAll(1, 4))
+	a.EqualNow(1, rb.IndexOf(2))
+	a.EqualNow(3, rb.LastIndexOf(2))
+	a.EqualNow(-1, rb.IndexOf(4))
+	a.EqualNow(-1, rb.LastIndexOf(4))
+
+	rb.AddAtIndex(1, 5)
+	a.EqualNow([]int{1, 5, 2, 3, 2, 1}, rb.ToSlice())
+	a.EqualNow(5, rb.RemoveAtIndex(1))
+	a.EqualNow(3, rb.Set(2, 4))
+	a.EqualNow([]int{1, 2, 4, 2, 1}, rb.ToSlice())
+
+	a.TrueNow(rb.RemoveFirst(2))
+	a.EqualNow([]int{1, 4, 2, 1}, rb.ToSlice())
+	a.TrueNow(rb.RemoveLast(1))
+	a.NotTrueNow(rb.RemoveLast(5))
+	a.EqualNow([]int{1, 4, 2}, rb.ToSlice())
+
+	rb.AddAll(1, 1)
+	a.EqualNow(0, rb.RemoveFirstN(1, 0))
+	a.EqualNow(2, rb.RemoveFirstN(1, 2))
+	a.EqualNow([]int{4, 2, 1}, rb.ToSlice())
+	rb.AddAll(4, 4)
+	a.EqualNow(0, rb.RemoveLastN(4, 0))
+	a.EqualNow(2, rb.RemoveLastN(4, 2))
+	a.EqualNow([]int{4, 2, 1}, rb.ToSlice())
+
+	a.EqualNow([]int{2, 1}, rb.SubList(1, 3).ToSlice())
+	a.EqualNow([]int{}, rb.SubList(2, 2).ToSlice())
+	a.PanicOfNow(func() {
+		rb.SubList(3, 1)
+	}, collection.ErrOutOfBounds)
+	a.PanicOfNow(func() {
+		rb.SubList(0, rb.Size()+1)
+	}, collection.ErrOutOfBounds)
+
+	rb.AddAll(5, 6)
+	a.EqualNow(0, rb.Trim(0))
+	a.EqualNow(1, rb.Trim(1))
+	a.EqualNow(0, rb.TrimLast(-1))
+	a.EqualNow(1, rb.TrimLast(1))
+	a.EqualNow([]int{2, 1, 5}, rb.ToSlice())
+	a.EqualNow(3, rb.TrimLast(5))
+	a.TrueNow(rb.IsEmpty())
+}
+
+func TestRingBufferCollection(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBufferFrom(5, OverwriteOldest, 1, 2, 3, 2, 4)
+
+	clone := rb.Clone()
+	a.TrueNow(rb.Equals(clone))
+	clone.Add(5)
+	a.NotTrueNow(rb.Equals(clone))
+	a.NotTrueNow(rb.Equals(NewRingBufferFrom(5, OverwriteOldest, 1, 2)))
+	a.NotTrueNow(rb.Equals(list.NewArrayListFrom(1, 2, 3, 2, 4)))
+
+	res := make([]int, 0, rb.Size())
+	a.NilNow(rb.ForEach(func(e int) error {
+		res = append(res, e)
+		return nil
+	}))
+	a.EqualNow(rb.ToSlice(), res)
+	expectedErr := errors.New("expected error")
+	a.EqualNow(expectedErr, rb.ForEach(func(e int) error {
+		return expectedErr
+	}))
+
+	a.TrueNow(rb.Remove(2))
+	a.NotTrueNow(rb.Remove(2))
+	a.NotTrueNow(rb.RemoveAll())
+	a.TrueNow(rb.RemoveAll(1, 6))
+	a.TrueNow(rb.RemoveIf(func(e int) bool { return e > 3 }))
+	a.EqualNow([]int{3}, rb.ToSlice())
+	rb.AddAll(4, 5)
+	a.TrueNow(rb.RetainAll(4))
+	a.NotTrueNow(rb.RetainAll(4))
+	a.EqualNow([]int{4}, rb.ToSlice())
+
+	rb.Clear()
+	a.TrueNow(rb.IsEmpty())
+	a.EqualNow(0, rb.Size())
+}
+
+func TestRingBufferJSON(t *testing.T) {
+	a := assert.New(t)
+	rb := NewRingBufferFrom(3, OverwriteOldest, 1, 2, 3, 4)
+
+	b, err := json.Marshal(rb)
+	a.NilNow(err)
+	a.EqualNow("[2,3,4]", string(b))
+
+	rb2 := NewRingBuffer[int](2, OverwriteOldest)
+	a.NilNow(json.Unmarshal(b, rb2))
+	a.EqualNow([]int{3, 4}, rb2.ToSlice())
+
+	rb3 := NewRingBuffer[int](2, PanicOnOverflow)
+	a.EqualNow(collection.ErrBufferFull, json.Unmarshal(b, rb3))
+	a.NotNilNow(json.Unmarshal([]byte(`{}`), rb3))
+
+	var rb4 RingBuffer[int]
+	a.NilNow(json.Unmarshal(b, &rb4))
+	a.EqualNow(3, rb4.Capacity())
+	a.EqualNow([]int{2, 3, 4}, rb4.ToSlice())
+
+	var rb5 RingBuffer[int]
+	a.EqualNow(collection.ErrInvalidCapacity, json.Unmarshal([]byte(`[]`), &rb5))
+}
+
+func TestLockRingBuffer(t *testing.T) {
+	a := assert.New(t)
+	l := list.NewLockList[int](NewRingBuffer[int](3, OverwriteOldest))
+
+	l.AddAll(1, 2, 3, 4)
+	a.EqualNow([]int{2, 3, 4}, l.ToSlice())
+	a.EqualNow(2, l.Get(0))
+}
+
+func BenchmarkRingBuffer_Add(b *testing.B) {
+	rb := NewRingBuffer[int](1024, OverwriteOldest)
+
+	for i := 0; i < b.N; i++ {
+		rb.Add(i)
+	}
+}
@@ -7,3 +7,49 @@ type Set[T comparable] interface {
 	// Clone returns a copy of this set.
 	Clone() Set[T]
 }
+
+// SortedSet is a set that keeps its elements in ascending order.
+type SortedSet[T comparable] interface {
+	Set[T]
+	SortedSetIter[T]
+
+	// Ceiling returns the least element in this set greater than or equal to the specified element,
+	// or false if there is no such element.
+	Ceiling(e T) (T, bool)
+
+	// First returns the first (lowest) element in this set, or false if this set is empty.
+	First() (T, bool)
+
+	// Floor returns the greatest element in this set less than or equal to the specified element, or
+	// false if there is no such element.
+	Floor(e T) (T, bool)
+
+	// HeadSet returns a set of the elements that are strictly less than toElement.
+	HeadSet(toElement T) SortedSet[T]
+
+	// Higher returns the least element in this set strictly greater than the specified element, or
+	// false if there is no such element.
+	Higher(e T) (T, bool)
+
+	// Last returns the last (highest) element in this set, or false if this set is empty.
+	Last() (T, bool)
+
+	// Lower returns the greatest element in this set strictly less than the specified element, or
+	// false if there is no such element.
+	Lower(e T) (T, bool)
+
+	// PollFirst removes and returns the first (lowest) element in this set, or false if this set is
+	// empty.
+	PollFirst() (T, bool)
+
+	// PollLast removes and returns the last (highest) element in this set, or false if this set is
+	// empty.
+	PollLast() (T, bool)
+
+	// SubSet returns a set of the elements that range from fromElement, inclusive, to toElement,
+	// exclusive.
+	SubSet(fromElement, toElement T) SortedSet[T]
+
+	// TailSet returns a set of the elements that are greater than or equal to fromElement.
+	TailSet(fromElement T) SortedSet[T]
+}
@@ -0,0 +1,172 @@
+package set
+
+import "github.com/ghosind/collection"
+
+// Union returns a new set that contains the elements in either s or o, the new set has the same
+// implementation as s. Both sets are not modified.
+func Union[T comparable](s, o collection.Set[T]) collection.Set[T] {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		set := make(HashSet[T], len(*hs)+len(*ho))
+		for e := range *hs {
+			set[e] = emptyZero
+		}
+		for e := range *ho {
+			set[e] = emptyZero
+		}
+		return &set
+	}
+
+	elements := o.ToSlice()
+	set := s.Clone()
+	set.AddAll(elements...)
+
+	return set
+}
+
+// Intersection returns a new set that contains the elements in both s and o, the new set has the
+// same implementation as s. Both sets are not modified.
+func Intersection[T comparable](s, o collection.Set[T]) collection.Set[T] {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		small, large := hs, ho
+		if len(*small) > len(*large) {
+			small, large = large, small
+		}
+
+		set := make(HashSet[T])
+		for e := range *small {
+			if _, ok := (*large)[e]; ok {
+				set[e] = emptyZero
+			}
+		}
+		return &set
+	}
+
+	other := hashSetOf(o)
+	set := s.Clone()
+	set.RemoveIf(func(e T) bool {
+		return !other.Contains(e)
+	})
+
+	return set
+}
+
+// Difference returns a new set that contains the elements in s but not in o, the new set has the
+// same implementation as s. Both sets are not modified.
+func Difference[T comparable](s, o collection.Set[T]) collection.Set[T] {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		set := make(HashSet[T])
+		for e := range *hs {
+			if _, ok := (*ho)[e]; !ok {
+				set[e] = emptyZero
+			}
+		}
+		return &set
+	}
+
+	elements := o.ToSlice()
+	set := s.Clone()
+	set.RemoveAll(elements...)
+
+	return set
+}
+
+// SymmetricDifference returns a new set that contains the elements in either s or o but not in
+// both of them, the new set has the same implementation as s. Both sets are not modified.
+func SymmetricDifference[T comparable](s, o collection.Set[T]) collection.Set[T] {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		set := make(HashSet[T])
+		for e := range *hs {
+			if _, ok := (*ho)[e]; !ok {
+				set[e] = emptyZero
+			}
+		}
+		for e := range *ho {
+			if _, ok := (*hs)[e]; !ok {
+				set[e] = emptyZero
+			}
+		}
+		return &set
+	}
+
+	elements := o.ToSlice()
+	set := s.Clone()
+	for _, e := range elements {
+		if !set.Remove(e) {
+			set.Add(e)
+		}
+	}
+
+	return set
+}
+
+// IsSubsetOf returns true if all the elements in s are also in o.
+func IsSubsetOf[T comparable](s, o collection.Set[T]) bool {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		if len(*hs) > len(*ho) {
+			return false
+		}
+		for e := range *hs {
+			if _, ok := (*ho)[e]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	return o.ContainsAll(s.ToSlice()...)
+}
+
+// IsSupersetOf returns true if s contains all the elements in o.
+func IsSupersetOf[T comparable](s, o collection.Set[T]) bool {
+	return IsSubsetOf(o, s)
+}
+
+// IsDisjoint returns true if s and o have no elements in common.
+func IsDisjoint[T comparable](s, o collection.Set[T]) bool {
+	if hs, ho, ok := bothHashSets(s, o); ok {
+		small, large := hs, ho
+		if len(*small) > len(*large) {
+			small, large = large, small
+		}
+
+		for e := range *small {
+			if _, ok := (*large)[e]; ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	other := hashSetOf(o)
+	for _, e := range s.ToSlice() {
+		if other.Contains(e) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bothHashSets returns the sets as HashSets if both of them are HashSets.
+func bothHashSets[T comparable](s, o collection.Set[T]) (*HashSet[T], *HashSet[T], bool) {
+	hs, ok := s.(*HashSet[T])
+	if !ok {
+		return nil, nil, false
+	}
+	ho, ok := o.(*HashSet[T])
+	if !ok {
+		return nil, nil, false
+	}
+
+	return hs, ho, true
+}
+
+// hashSetOf returns the set itself if it is a HashSet, or returns a HashSet that contains a
+// snapshot of its elements, so the thread-safe sets are only locked once.
+func hashSetOf[T comparable](s collection.Set[T]) *HashSet[T] {
+	if hs, ok := s.(*HashSet[T]); ok {
+		return hs
+	}
+
+	return NewHashSetFrom(s.ToSlice()...)
+}
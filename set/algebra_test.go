@@ -0,0 +1,161 @@
+package set
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+var algebraConstructors = map[string]func(...int) collection.Set[int]{
+	"HashSet": func(c ...int) collection.Set[int] {
+		return NewHashSetFrom(c...)
+	},
+	"LinkedHashSet": func(c ...int) collection.Set[int] {
+		return NewLinkedHashSetFrom(c...)
+	},
+	"TreeSet": func(c ...int) collection.Set[int] {
+		s := NewOrderedTreeSet[int]()
+		s.AddAll(c...)
+		return s
+	},
+	"SyncSet": func(c ...int) collection.Set[int] {
+		return NewSyncSetFrom(c...)
+	},
+	"LockSet": func(c ...int) collection.Set[int] {
+		return NewLockSet[int](NewHashSetFrom(c...))
+	},
+}
+
+func sortedSlice(s collection.Set[int]) []int {
+	values := s.ToSlice()
+	sort.Ints(values)
+	return values
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := assert.New(t)
+
+	for sName, sCtor := range algebraConstructors {
+		for oName, oCtor := range algebraConstructors {
+			s := sCtor(1, 2, 3, 4)
+			o := oCtor(3, 4, 5)
+			empty := oCtor()
+
+			union := Union(s, o)
+			a.EqualNow([]int{1, 2, 3, 4, 5}, sortedSlice(union), sName, oName)
+			a.EqualNow(sortedSlice(Union(s, empty)), sortedSlice(s), sName, oName)
+
+			intersection := Intersection(s, o)
+			a.EqualNow([]int{3, 4}, sortedSlice(intersection), sName, oName)
+			a.TrueNow(Intersection(s, empty).IsEmpty(), sName, oName)
+
+			difference := Difference(s, o)
+			a.EqualNow([]int{1, 2}, sortedSlice(difference), sName, oName)
+			a.EqualNow([]int{5}, sortedSlice(Difference(o, s)), sName, oName)
+
+			symmetric := SymmetricDifference(s, o)
+			a.EqualNow([]int{1, 2, 5}, sortedSlice(symmetric), sName, oName)
+
+			for _, result := range []collection.Set[int]{union, intersection, difference, symmetric} {
+				a.TrueNow(sameImplementation(s, result), sName, oName)
+			}
+			a.EqualNow([]int{1, 2, 3, 4}, sortedSlice(s), sName, oName)
+			a.EqualNow([]int{3, 4, 5}, sortedSlice(o), sName, oName)
+
+			a.NotTrueNow(IsSubsetOf(s, o), sName, oName)
+			a.TrueNow(IsSubsetOf(intersection, o), sName, oName)
+			a.TrueNow(IsSubsetOf(empty, s), sName, oName)
+			a.TrueNow(IsSupersetOf(s, oCtor(1, 2)), sName, oName)
+			a.NotTrueNow(IsSupersetOf(s, o), sName, oName)
+			a.TrueNow(IsSupersetOf(s, empty), sName, oName)
+
+			a.NotTrueNow(IsDisjoint(s, o), sName, oName)
+			a.TrueNow(IsDisjoint(s, oCtor(5, 6)), sName, oName)
+			a.TrueNow(IsDisjoint(s, empty), sName, oName)
+		}
+	}
+}
+
+func TestSetAlgebraSelf(t *testing.T) {
+	a := assert.New(t)
+
+	for name, ctor := range algebraConstructors {
+		s := ctor(1, 2, 3)
+
+		a.EqualNow([]int{1, 2, 3}, sortedSlice(Union(s, s)), name)
+		a.EqualNow([]int{1, 2, 3}, sortedSlice(Intersection(s, s)), name)
+		a.TrueNow(Difference(s, s).IsEmpty(), name)
+		a.TrueNow(SymmetricDifference(s, s).IsEmpty(), name)
+		a.TrueNow(IsSubsetOf(s, s), name)
+		a.TrueNow(IsSupersetOf(s, s), name)
+		a.NotTrueNow(IsDisjoint(s, s), name)
+	}
+}
+
+func TestSetAlgebraConcurrent(t *testing.T) {
+	a := assert.New(t)
+	sets := []collection.Set[int]{
+		NewSyncSetFrom(1, 2, 3),
+		NewLockSet[int](NewHashSetFrom(1, 2, 3)),
+	}
+
+	for _, s := range sets {
+		var o collection.Set[int] = NewLockSet[int](NewHashSetFrom(2, 3, 4))
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					s.Add(n*100 + j + 10)
+					o.Add(n*100 + j + 10)
+					Union(s, o)
+					Intersection(o, s)
+					Difference(s, o)
+					SymmetricDifference(o, s)
+					IsSubsetOf(s, o)
+					IsDisjoint(o, s)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		a.EqualNow([]int{1}, sortedSlice(Difference(s, o)))
+		a.EqualNow([]int{1, 4}, sortedSlice(SymmetricDifference(s, o)))
+		a.EqualNow(402, Intersection(s, o).Size())
+	}
+}
+
+func TestSetAlgebraTypeArgument(t *testing.T) {
+	a := assert.New(t)
+	s := NewHashSetFrom(1, 2)
+	o := NewLockSet[int](NewHashSetFrom(2, 3))
+
+	a.EqualNow([]int{1, 2, 3}, sortedSlice(Union[int](s, o)))
+	a.EqualNow([]int{2}, sortedSlice(Intersection[int](o, s)))
+}
+
+func sameImplementation(s, o collection.Set[int]) bool {
+	switch s.(type) {
+	case *HashSet[int]:
+		_, ok := o.(*HashSet[int])
+		return ok
+	case *LinkedHashSet[int]:
+		_, ok := o.(*LinkedHashSet[int])
+		return ok
+	case *TreeSet[int]:
+		_, ok := o.(*TreeSet[int])
+		return ok
+	case *SyncSet[int]:
+		_, ok := o.(*SyncSet[int])
+		return ok
+	case *LockSet[int]:
+		_, ok := o.(*LockSet[int])
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// linkedHashSetNode is an element in the LinkedHashSet, it is also a node of the doubly linked
+// list that keeps the insertion order of the elements.
+type linkedHashSetNode[T comparable] struct {
+	value T
+	prev  *linkedHashSetNode[T]
+	next  *linkedHashSetNode[T]
+}
+
+// LinkedHashSet is a hash table based set that keeps a doubly linked list running through all of
+// its elements, so the elements are iterated in the order they were inserted.
+type LinkedHashSet[T comparable] struct {
+	nodes map[T]*linkedHashSetNode[T]
+	head  *linkedHashSetNode[T]
+	tail  *linkedHashSetNode[T]
+}
+
+// NewLinkedHashSet creates a new LinkedHashSet.
+func NewLinkedHashSet[T comparable]() *LinkedHashSet[T] {
+	set := new(LinkedHashSet[T])
+	set.nodes = make(map[T]*linkedHashSetNode[T])
+
+	return set
+}
+
+// NewLinkedHashSetFrom creates and returns a new LinkedHashSet containing the elements of the
+// provided collection in the same order.
+func NewLinkedHashSetFrom[T comparable](c ...T) *LinkedHashSet[T] {
+	set := NewLinkedHashSet[T]()
+	set.AddAll(c...)
+
+	return set
+}
+
+// Add adds the specified element to the end of this set if it is not already present.
+func (set *LinkedHashSet[T]) Add(e T) bool {
+	if _, found := set.nodes[e]; found {
+		return false
+	}
+
+	node := &linkedHashSetNode[T]{value: e, prev: set.tail}
+	if set.tail == nil {
+		set.head = node
+	} else {
+		set.tail.next = node
+	}
+	set.tail = node
+	set.nodes[e] = node
+
+	return true
+}
+
+// AddAll adds all of the specified elements to this set.
+func (set *LinkedHashSet[T]) AddAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if set.Add(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// Clear removes all of the elements from this set.
+func (set *LinkedHashSet[T]) Clear() {
+	set.nodes = make(map[T]*linkedHashSetNode[T])
+	set.head = nil
+	set.tail = nil
+}
+
+// Clone returns a copy of this set.
+func (set *LinkedHashSet[T]) Clone() collection.Set[T] {
+	return NewLinkedHashSetFrom(set.ToSlice()...)
+}
+
+// Contains returns true if this set contains the specified element.
+func (set *LinkedHashSet[T]) Contains(e T) bool {
+	_, found := set.nodes[e]
+
+	return found
+}
+
+// ContainsAll returns true if this set contains all of the specified elements.
+func (set *LinkedHashSet[T]) ContainsAll(c ...T) bool {
+	for _, e := range c {
+		if _, found := set.nodes[e]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals compares set with the object pass from parameter.
+func (set *LinkedHashSet[T]) Equals(o any) bool {
+	s, ok := o.(*LinkedHashSet[T])
+	if !ok {
+		return false
+	}
+
+	if s.Size() != set.Size() {
+		return false
+	}
+
+	for e := range set.nodes {
+		if _, found := s.nodes[e]; !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ForEach performs the given handler for each elements in the set in insertion order until all
+// elements have been processed or the handler returns an error.
+func (set *LinkedHashSet[T]) ForEach(handler func(e T) error) error {
+	for node := set.head; node != nil; {
+		next := node.next
+		if err := handler(node.value); err != nil {
+			return err
+		}
+		node = next
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (set *LinkedHashSet[T]) IsEmpty() bool {
+	return set.Size() == 0
+}
+
+// Remove removes the specified element from this set.
+func (set *LinkedHashSet[T]) Remove(e T) bool {
+	node, found := set.nodes[e]
+	if !found {
+		return false
+	}
+
+	set.removeNode(node)
+	return true
+}
+
+// RemoveAll removes all of the specified elements from this set.
+func (set *LinkedHashSet[T]) RemoveAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if set.Remove(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// RemoveIf removes all of the elements of this set that satisfy the given predicate.
+func (set *LinkedHashSet[T]) RemoveIf(filter func(T) bool) bool {
+	isChanged := false
+
+	for node := set.head; node != nil; {
+		next := node.next
+		if filter(node.value) {
+			set.removeNode(node)
+			isChanged = true
+		}
+		node = next
+	}
+
+	return isChanged
+}
+
+// RetainAll retains only the elements in this set that are contained in the specified collection.
+func (set *LinkedHashSet[T]) RetainAll(c ...T) bool {
+	cSet := NewHashSetFrom(c...)
+
+	return set.RemoveIf(func(e T) bool {
+		return !cSet.Contains(e)
+	})
+}
+
+// Size returns the number of elements in this set.
+func (set *LinkedHashSet[T]) Size() int {
+	return len(set.nodes)
+}
+
+// String returns the string representation of this set.
+func (set *LinkedHashSet[T]) String() string {
+	buf := bytes.NewBufferString("set[")
+	for node := set.head; node != nil; node = node.next {
+		if node != set.head {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(internal.ValueString(node.value))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// ToSlice returns a slice containing all of the elements in this set in insertion order.
+func (set *LinkedHashSet[T]) ToSlice() []T {
+	slice := make([]T, 0, set.Size())
+
+	for node := set.head; node != nil; node = node.next {
+		slice = append(slice, node.value)
+	}
+
+	return slice
+}
+
+// MarshalJSON marshals the set as a JSON array in insertion order.
+func (set *LinkedHashSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the set, the elements are inserted in the order they
+// appear in the JSON array.
+func (set *LinkedHashSet[T]) UnmarshalJSON(b []byte) error {
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+
+	set.Clear()
+	set.AddAll(items...)
+
+	return nil
+}
+
+func (set *LinkedHashSet[T]) removeNode(node *linkedHashSetNode[T]) {
+	delete(set.nodes, node.value)
+
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		set.head = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		set.tail = node.prev
+	}
+	node.prev = nil
+	node.next = nil
+}
@@ -0,0 +1,18 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// Iter returns an iterator of all elements in this set in insertion order.
+func (set *LinkedHashSet[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := set.head; node != nil; {
+			next := node.next
+			if !yield(node.value) {
+				break
+			}
+			node = next
+		}
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !go1.23
+
+package set
+
+// Iter returns a channel of all elements in this set in insertion order.
+func (set *LinkedHashSet[T]) Iter() <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		for node := set.head; node != nil; node = node.next {
+			ch <- node.value
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
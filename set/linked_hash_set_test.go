@@ -0,0 +1,70 @@
+package set
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+var linkedHashSetConstructor = func(initData ...[]int) collection.Set[int] {
+	if len(initData) > 0 && len(initData[0]) > 0 {
+		return NewLinkedHashSetFrom(initData[0]...)
+	}
+	return NewLinkedHashSet[int]()
+}
+
+func TestLinkedHashSet(t *testing.T) {
+	a := assert.New(t)
+
+	testSet(a, linkedHashSetConstructor)
+}
+
+func BenchmarkLinkedHashSet_Add(b *testing.B) {
+	benchmarkSet_Add(b, linkedHashSetConstructor, false)
+}
+
+func BenchmarkLinkedHashSet_Contains(b *testing.B) {
+	benchmarkSet_Contains(b, linkedHashSetConstructor, false)
+}
+
+func TestLinkedHashSetOrder(t *testing.T) {
+	a := assert.New(t)
+	set := NewLinkedHashSetFrom(testNums1...)
+
+	a.EqualNow(testNums1, set.ToSlice())
+	a.EqualNow("set[47 11 42 13 37 23 31 29 17 19]", set.String())
+
+	// adding an existing element should not change the order
+	a.NotTrueNow(set.Add(47))
+	a.EqualNow(testNums1, set.ToSlice())
+
+	set.Remove(47)
+	set.Add(47)
+	a.EqualNow(append(testNums1[1:len(testNums1):len(testNums1)], 47), set.ToSlice())
+
+	set.RemoveIf(func(e int) bool {
+		return e%2 == 0
+	})
+	a.EqualNow([]int{11, 13, 37, 23, 31, 29, 17, 19, 47}, set.ToSlice())
+
+	res := make([]int, 0, set.Size())
+	err := set.ForEach(func(e int) error {
+		res = append(res, e)
+		return nil
+	})
+	a.NilNow(err)
+	a.EqualNow(set.ToSlice(), res)
+
+	b, err := json.Marshal(set)
+	a.NilNow(err)
+	a.EqualNow("[11,13,37,23,31,29,17,19,47]", string(b))
+
+	set2 := NewLinkedHashSet[int]()
+	a.NilNow(json.Unmarshal(b, set2))
+	a.EqualNow(set.ToSlice(), set2.ToSlice())
+
+	clone := set.Clone()
+	a.EqualNow(set.ToSlice(), clone.ToSlice())
+}
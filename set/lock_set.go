@@ -158,6 +158,29 @@ func (s *LockSet[T]) ToSlice() []T {
 	return s.data.ToSlice()
 }
 
+// Update calls the update function with a transaction of this set under the write lock, and
+// applies all the changes made by the transaction to this set atomically after the function
+// returns. None of the changes are applied if the function panics. It returns the elements that
+// were actually added to and removed from this set. The update function must not call the methods of
+// this set.
+func (s *LockSet[T]) Update(fn func(tx SetTx[T])) (added, removed []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := newSetTx(s.data.Contains)
+	fn(tx)
+
+	added, removed = tx.result()
+	for _, e := range added {
+		s.data.Add(e)
+	}
+	for _, e := range removed {
+		s.data.Remove(e)
+	}
+
+	return added, removed
+}
+
 // MarshalJSON marshals the set as a JSON array.
 func (s *LockSet[T]) MarshalJSON() ([]byte, error) {
 	s.mu.RLock()
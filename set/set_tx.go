@@ -0,0 +1,127 @@
+package set
+
+// SetTx is the transaction of an atomic batch update of a set, it is passed to the update function
+// of SyncSet.Update and LockSet.Update. The changes made by the transaction are buffered, and they
+// are applied to the set all together after the update function returns, or none of them are
+// applied if the update function panics. The transaction must not be used after the update function
+// returns.
+type SetTx[T comparable] interface {
+	// Add adds the specified element to the set, and returns true if the set did not already contain
+	// the element.
+	Add(e T) bool
+
+	// AddAll adds all of the specified elements to the set, and returns true if the set changed.
+	AddAll(c ...T) bool
+
+	// Contains returns true if the set contains the specified element, including the changes made by
+	// this transaction.
+	Contains(e T) bool
+
+	// Remove removes the specified element from the set, and returns true if the set contained the
+	// element.
+	Remove(e T) bool
+
+	// RemoveAll removes all of the specified elements from the set, and returns true if the set
+	// changed.
+	RemoveAll(c ...T) bool
+}
+
+// setTx is the SetTx implementation that records the membership of the elements changed in the
+// transaction, and reads the other elements from the underlying set.
+type setTx[T comparable] struct {
+	contains func(T) bool
+	changes  map[T]bool
+	order    []T
+}
+
+// newSetTx creates a new transaction of the set with the specified contains function.
+func newSetTx[T comparable](contains func(T) bool) *setTx[T] {
+	tx := new(setTx[T])
+	tx.contains = contains
+	tx.changes = make(map[T]bool)
+
+	return tx
+}
+
+// Add adds the specified element to the set.
+func (tx *setTx[T]) Add(e T) bool {
+	if tx.Contains(e) {
+		return false
+	}
+
+	tx.set(e, true)
+
+	return true
+}
+
+// AddAll adds all of the specified elements to the set.
+func (tx *setTx[T]) AddAll(c ...T) bool {
+	isChanged := false
+	for _, e := range c {
+		if tx.Add(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// Contains returns true if the set contains the specified element.
+func (tx *setTx[T]) Contains(e T) bool {
+	if present, ok := tx.changes[e]; ok {
+		return present
+	}
+
+	return tx.contains(e)
+}
+
+// Remove removes the specified element from the set.
+func (tx *setTx[T]) Remove(e T) bool {
+	if !tx.Contains(e) {
+		return false
+	}
+
+	tx.set(e, false)
+
+	return true
+}
+
+// RemoveAll removes all of the specified elements from the set.
+func (tx *setTx[T]) RemoveAll(c ...T) bool {
+	isChanged := false
+	for _, e := range c {
+		if tx.Remove(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// result returns the elements added to and removed from the underlying set by this transaction, in
+// the order they were first changed. The elements that were changed back to their original
+// membership are not included.
+func (tx *setTx[T]) result() (added, removed []T) {
+	for _, e := range tx.order {
+		present := tx.changes[e]
+		if present == tx.contains(e) {
+			continue
+		}
+
+		if present {
+			added = append(added, e)
+		} else {
+			removed = append(removed, e)
+		}
+	}
+
+	return added, removed
+}
+
+// set records the membership of the element in this transaction.
+func (tx *setTx[T]) set(e T, present bool) {
+	if _, ok := tx.changes[e]; !ok {
+		tx.order = append(tx.order, e)
+	}
+	tx.changes[e] = present
+}
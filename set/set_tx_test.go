@@ -0,0 +1,149 @@
+package set
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+type updatableSet interface {
+	collection.Set[int]
+	Update(fn func(tx SetTx[int])) (added, removed []int)
+}
+
+func testSetUpdate(a *assert.Assertion, constructor func(...int) updatableSet) {
+	s := constructor(1, 2, 3)
+
+	added, removed := s.Update(func(tx SetTx[int]) {
+		a.TrueNow(tx.Contains(1))
+		a.NotTrueNow(tx.Add(1))
+		a.TrueNow(tx.Add(4))
+		a.TrueNow(tx.Contains(4))
+
+		a.TrueNow(tx.Remove(2))
+		a.NotTrueNow(tx.Contains(2))
+		a.NotTrueNow(tx.Remove(2))
+
+		a.TrueNow(tx.AddAll(5, 6))
+		a.TrueNow(tx.RemoveAll(6, 7))
+		a.NotTrueNow(tx.RemoveAll(7, 8))
+
+		a.TrueNow(tx.Remove(3))
+		a.TrueNow(tx.Add(3))
+	})
+	a.EqualNow([]int{4, 5}, added)
+	a.EqualNow([]int{2}, removed)
+
+	values := s.ToSlice()
+	sort.Ints(values)
+	a.EqualNow([]int{1, 3, 4, 5}, values)
+
+	added, removed = s.Update(func(tx SetTx[int]) {
+		tx.Add(1)
+		tx.Remove(10)
+	})
+	a.EqualNow(0, len(added))
+	a.EqualNow(0, len(removed))
+	a.EqualNow(4, s.Size())
+
+	a.PanicOfNow(func() {
+		s.Update(func(tx SetTx[int]) {
+			tx.RemoveAll(1, 3, 4, 5)
+			tx.Add(6)
+			panic("failed")
+		})
+	}, "failed")
+	values = s.ToSlice()
+	sort.Ints(values)
+	a.EqualNow([]int{1, 3, 4, 5}, values)
+
+	s.Add(7)
+	a.TrueNow(s.Contains(7))
+	a.TrueNow(s.Remove(4))
+	a.EqualNow(4, s.Size())
+}
+
+func testSetUpdateConcurrent(a *assert.Assertion, constructor func(...int) updatableSet) {
+	s := constructor()
+	addedCount := make([]int, 8)
+	removedCount := make([]int, 8)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				added, removed := s.Update(func(tx SetTx[int]) {
+					tx.AddAll(j, j+1, j+2)
+					tx.Remove(j - 1)
+				})
+				addedCount[n] += len(added)
+				removedCount[n] += len(removed)
+				s.Contains(j)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range addedCount {
+		total += addedCount[i] - removedCount[i]
+	}
+	a.EqualNow(total, s.Size())
+	a.EqualNow(3, s.Size())
+	a.TrueNow(s.ContainsAll(99, 100, 101))
+}
+
+func TestSyncSetUpdate(t *testing.T) {
+	a := assert.New(t)
+	constructor := func(c ...int) updatableSet {
+		return NewSyncSetFrom(c...)
+	}
+
+	testSetUpdate(a, constructor)
+	testSetUpdateConcurrent(a, constructor)
+}
+
+func TestLockSetUpdate(t *testing.T) {
+	a := assert.New(t)
+	constructor := func(c ...int) updatableSet {
+		return NewLockSet[int](NewHashSetFrom(c...))
+	}
+
+	testSetUpdate(a, constructor)
+	testSetUpdateConcurrent(a, constructor)
+}
+
+func TestLockSetUpdateAtomic(t *testing.T) {
+	a := assert.New(t)
+	s := NewLockSet[int](NewHashSetFrom(0, 1, 2))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			s.Update(func(tx SetTx[int]) {
+				tx.RemoveAll(i, i+1, i+2)
+				tx.AddAll(i+1, i+2, i+3)
+			})
+		}
+	}()
+
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+
+		values := s.ToSlice()
+		a.EqualNow(3, len(values))
+		sort.Ints(values)
+		a.EqualNow(values[0]+1, values[1])
+		a.EqualNow(values[1]+1, values[2])
+	}
+}
@@ -42,8 +42,11 @@ func NewSyncSetFrom[T comparable](c ...T) *SyncSet[T] {
 func (s *SyncSet[T]) Add(val T) bool {
 	read := s.loadReadOnly()
 	if e, ok := read.M[val]; ok {
-		_, ok := e.TrySwap(&emptyZero)
-		return !ok
+		// the deleted entries cannot be told apart from the expunged ones, so they are added
+		// under the lock.
+		if _, ok := e.TrySwap(&emptyZero); ok {
+			return false
+		}
 	}
 
 	s.mu.Lock()
@@ -51,7 +54,7 @@ func (s *SyncSet[T]) Add(val T) bool {
 
 	read = s.loadReadOnly()
 	if e, ok := read.M[val]; ok {
-		if e.UnexpungeLocked() {
+		if e.UnexpungeLocked() && s.dirty != nil {
 			s.dirty[val] = e
 		}
 		if v := e.SwapLocked(&emptyZero); v != nil {
@@ -78,9 +81,10 @@ func (s *SyncSet[T]) AddAll(c ...T) bool {
 
 	read := s.loadReadOnly()
 	for _, val := range c {
-		if e, ok := read.M[val]; ok {
-			_, ok := e.TrySwap(&emptyZero)
-			return !ok
+		if e, ok := read.M[val]; ok && !isLocked {
+			if _, ok := e.TrySwap(&emptyZero); ok {
+				continue
+			}
 		}
 
 		if !isLocked {
@@ -92,7 +96,7 @@ func (s *SyncSet[T]) AddAll(c ...T) bool {
 		}
 
 		if e, ok := read.M[val]; ok {
-			if e.UnexpungeLocked() {
+			if e.UnexpungeLocked() && s.dirty != nil {
 				s.dirty[val] = e
 			}
 			if v := e.SwapLocked(&emptyZero); v != nil {
@@ -378,6 +382,61 @@ func (s *SyncSet[T]) ToSlice() []T {
 	return slice
 }
 
+// Update calls the update function with a transaction of this set under the lock, and applies all
+// the changes made by the transaction to this set atomically after the function returns, so the
+// other goroutines see either all or none of the changes. None of the changes are applied if the
+// function panics. It returns the elements that were actually added to and removed from this set.
+// The update function must not call the methods of this set.
+func (s *SyncSet[T]) Update(fn func(tx SetTx[T])) (added, removed []T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	read := s.loadReadOnly()
+	entries := read.M
+	if read.Amended {
+		entries = s.dirty
+	}
+
+	tx := newSetTx(func(k T) bool {
+		e, ok := entries[k]
+		if !ok {
+			return false
+		}
+		_, ok = e.Load(emptyZero)
+		return ok
+	})
+	fn(tx)
+
+	added, removed = tx.result()
+	if len(added) == 0 && len(removed) == 0 {
+		return added, removed
+	}
+
+	m := make(map[T]*internal.SyncEntry[empty], len(entries)+len(added))
+	for k, e := range entries {
+		if present, ok := tx.changes[k]; ok && !present {
+			continue
+		}
+		if _, ok := e.Load(emptyZero); ok {
+			m[k] = e
+		}
+	}
+	for _, k := range added {
+		m[k] = internal.NewSyncEntry(emptyZero, nilEmpty)
+	}
+
+	s.read.Store(&internal.SyncReadOnly[T, empty]{M: m})
+	s.dirty = nil
+	s.misses = 0
+
+	// the removed elements may still be read from the previous map by the concurrent calls.
+	for _, k := range removed {
+		entries[k].Delete()
+	}
+
+	return added, removed
+}
+
 // MarshalJSON marshals the SyncSet as a JSON array.
 func (s *SyncSet[T]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.ToSlice())
@@ -20,6 +20,22 @@ func TestSyncSet(t *testing.T) {
 	testSet(a, syncSetConstructor)
 }
 
+func TestSyncSetAddDeleted(t *testing.T) {
+	a := assert.New(t)
+	s := NewSyncSetFrom(1, 2, 3)
+
+	a.TrueNow(s.Remove(1))
+	a.TrueNow(s.Add(1))
+	a.TrueNow(s.Contains(1))
+	a.NotTrueNow(s.Add(1))
+
+	a.TrueNow(s.Remove(2))
+	a.TrueNow(s.AddAll(3, 2, 4))
+	a.TrueNow(s.ContainsAll(1, 2, 3, 4))
+	a.NotTrueNow(s.AddAll(1, 2, 3, 4))
+	a.EqualNow(4, s.Size())
+}
+
 func BenchmarkSyncSet_Add(b *testing.B) {
 	benchmarkSet_Add(b, syncSetConstructor, true)
 }
@@ -0,0 +1,332 @@
+package set
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/internal"
+)
+
+// TreeSet is a set implementation based on red-black tree, the elements are sorted with the
+// comparator. The sets returned by HeadSet, SubSet and TailSet are the views of the same tree that
+// only contain the elements in their ranges.
+type TreeSet[T comparable] struct {
+	tree *internal.RBView[T, empty]
+}
+
+// NewTreeSet creates a new TreeSet with the specified comparator. The comparator returns a negative
+// number if a < b, zero if a == b, and a positive number if a > b.
+func NewTreeSet[T comparable](cmp func(a, b T) int) *TreeSet[T] {
+	set := new(TreeSet[T])
+	set.tree = internal.NewRBView(internal.NewRBTree[T, empty](cmp))
+
+	return set
+}
+
+// NewTreeSetFrom creates and returns a new TreeSet with the specified comparator containing the
+// elements of the provided collection.
+func NewTreeSetFrom[T comparable](cmp func(a, b T) int, c ...T) *TreeSet[T] {
+	set := NewTreeSet(cmp)
+	for _, e := range c {
+		set.tree.Insert(e, emptyZero)
+	}
+
+	return set
+}
+
+// Add adds the specified element to this set.
+func (set *TreeSet[T]) Add(e T) bool {
+	_, inserted := set.tree.Insert(e, emptyZero)
+
+	return inserted
+}
+
+// AddAll adds all of the specified elements to this set.
+func (set *TreeSet[T]) AddAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if _, inserted := set.tree.Insert(e, emptyZero); inserted {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// Ceiling returns the least element in this set greater than or equal to the specified element, or
+// false if there is no such element.
+func (set *TreeSet[T]) Ceiling(e T) (T, bool) {
+	return set.nodeElement(set.tree.Ceiling(e))
+}
+
+// Clear removes all of the elements from this set.
+func (set *TreeSet[T]) Clear() {
+	set.tree.Clear()
+}
+
+// Clone returns a copy of this set, the copy of a view is a new set without the range restriction.
+func (set *TreeSet[T]) Clone() collection.Set[T] {
+	newSet := new(TreeSet[T])
+	newSet.tree = internal.NewRBView(set.tree.Clone())
+
+	return newSet
+}
+
+// Contains returns true if this set contains the specified element.
+func (set *TreeSet[T]) Contains(e T) bool {
+	return set.tree.Find(e) != nil
+}
+
+// ContainsAll returns true if this set contains all of the specified elements.
+func (set *TreeSet[T]) ContainsAll(c ...T) bool {
+	for _, e := range c {
+		if set.tree.Find(e) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals compares set with the object pass from parameter.
+func (set *TreeSet[T]) Equals(o any) bool {
+	s, ok := o.(*TreeSet[T])
+	if !ok {
+		return false
+	}
+
+	if s.Size() != set.Size() {
+		return false
+	}
+
+	for n := set.tree.First(); n != nil; n = set.tree.Next(n) {
+		if s.tree.Find(n.Key) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// First returns the first (lowest) element in this set, or false if this set is empty.
+func (set *TreeSet[T]) First() (T, bool) {
+	return set.nodeElement(set.tree.First())
+}
+
+// Floor returns the greatest element in this set less than or equal to the specified element, or
+// false if there is no such element.
+func (set *TreeSet[T]) Floor(e T) (T, bool) {
+	return set.nodeElement(set.tree.Floor(e))
+}
+
+// ForEach performs the given handler for each elements in the set in ascending order until all
+// elements have been processed or the handler returns an error.
+func (set *TreeSet[T]) ForEach(handler func(e T) error) error {
+	for n := set.tree.First(); n != nil; {
+		next := set.tree.Next(n)
+		if err := handler(n.Key); err != nil {
+			return err
+		}
+		n = next
+	}
+
+	return nil
+}
+
+// HeadSet returns a view of the portion of this set whose elements are strictly less than
+// toElement. The changes in the view are reflected in this set and vice versa, and adding an
+// element out of the range into the view panics with ErrKeyOutOfRange.
+func (set *TreeSet[T]) HeadSet(toElement T) collection.SortedSet[T] {
+	var zero T
+	return set.view(zero, false, toElement, true)
+}
+
+// Higher returns the least element in this set strictly greater than the specified element, or
+// false if there is no such element.
+func (set *TreeSet[T]) Higher(e T) (T, bool) {
+	return set.nodeElement(set.tree.Higher(e))
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (set *TreeSet[T]) IsEmpty() bool {
+	return set.Size() == 0
+}
+
+// Last returns the last (highest) element in this set, or false if this set is empty.
+func (set *TreeSet[T]) Last() (T, bool) {
+	return set.nodeElement(set.tree.Last())
+}
+
+// Lower returns the greatest element in this set strictly less than the specified element, or
+// false if there is no such element.
+func (set *TreeSet[T]) Lower(e T) (T, bool) {
+	return set.nodeElement(set.tree.Lower(e))
+}
+
+// PollFirst removes and returns the first (lowest) element in this set, or false if this set is
+// empty.
+func (set *TreeSet[T]) PollFirst() (T, bool) {
+	n := set.tree.First()
+	if n != nil {
+		set.tree.Delete(n)
+	}
+
+	return set.nodeElement(n)
+}
+
+// PollLast removes and returns the last (highest) element in this set, or false if this set is
+// empty.
+func (set *TreeSet[T]) PollLast() (T, bool) {
+	n := set.tree.Last()
+	if n != nil {
+		set.tree.Delete(n)
+	}
+
+	return set.nodeElement(n)
+}
+
+// Remove removes the specified element from this set.
+func (set *TreeSet[T]) Remove(e T) bool {
+	n := set.tree.Find(e)
+	if n == nil {
+		return false
+	}
+
+	set.tree.Delete(n)
+	return true
+}
+
+// RemoveAll removes all of the specified elements from this set.
+func (set *TreeSet[T]) RemoveAll(c ...T) bool {
+	isChanged := false
+
+	for _, e := range c {
+		if set.Remove(e) {
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+// RemoveIf removes all of the elements of this set that satisfy the given predicate.
+func (set *TreeSet[T]) RemoveIf(filter func(T) bool) bool {
+	isChanged := false
+
+	for n := set.tree.First(); n != nil; {
+		next := set.tree.Next(n)
+		if filter(n.Key) {
+			set.tree.Delete(n)
+			isChanged = true
+		}
+		n = next
+	}
+
+	return isChanged
+}
+
+// RetainAll retains only the elements in this set that are contained in the specified collection.
+func (set *TreeSet[T]) RetainAll(c ...T) bool {
+	cSet := NewHashSetFrom(c...)
+
+	return set.RemoveIf(func(e T) bool {
+		return !cSet.Contains(e)
+	})
+}
+
+// Size returns the number of elements in this set.
+func (set *TreeSet[T]) Size() int {
+	return set.tree.Size()
+}
+
+// String returns the string representation of this set.
+func (set *TreeSet[T]) String() string {
+	buf := bytes.NewBufferString("set[")
+	first := true
+	for n := set.tree.First(); n != nil; n = set.tree.Next(n) {
+		if !first {
+			buf.WriteString(" ")
+		}
+		first = false
+		buf.WriteString(internal.ValueString(n.Key))
+	}
+	buf.WriteString("]")
+	return buf.String()
+}
+
+// SubSet returns a view of the portion of this set whose elements range from fromElement,
+// inclusive, to toElement, exclusive. The changes in the view are reflected in this set and vice
+// versa, and adding an element out of the range into the view panics with ErrKeyOutOfRange.
+func (set *TreeSet[T]) SubSet(fromElement, toElement T) collection.SortedSet[T] {
+	return set.view(fromElement, true, toElement, true)
+}
+
+// TailSet returns a view of the portion of this set whose elements are greater than or equal to
+// fromElement. The changes in the view are reflected in this set and vice versa, and adding an
+// element out of the range into the view panics with ErrKeyOutOfRange.
+func (set *TreeSet[T]) TailSet(fromElement T) collection.SortedSet[T] {
+	var zero T
+	return set.view(fromElement, true, zero, false)
+}
+
+// ToSlice returns a slice containing all of the elements in this set in ascending order.
+func (set *TreeSet[T]) ToSlice() []T {
+	slice := make([]T, 0, set.Size())
+
+	for n := set.tree.First(); n != nil; n = set.tree.Next(n) {
+		slice = append(slice, n.Key)
+	}
+
+	return slice
+}
+
+// MarshalJSON marshals the set as a JSON array in ascending order.
+func (set *TreeSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(set.ToSlice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the set. The TreeSet must be created with a comparator
+// before unmarshaling.
+func (set *TreeSet[T]) UnmarshalJSON(b []byte) error {
+	if set.tree == nil {
+		return collection.ErrNoComparator
+	}
+
+	var items []T
+	if err := json.Unmarshal(b, &items); err != nil {
+		return err
+	}
+	for _, v := range items {
+		if !set.tree.InRange(v) {
+			return collection.ErrKeyOutOfRange
+		}
+	}
+
+	set.tree.Clear()
+	for _, v := range items {
+		set.tree.Insert(v, emptyZero)
+	}
+
+	return nil
+}
+
+// nodeElement returns the element of the node, or false if the node is nil.
+func (set *TreeSet[T]) nodeElement(n *internal.RBNode[T, empty]) (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+
+	return n.Key, true
+}
+
+// view returns a view of this set with the specified bounds, the bounds of the view are narrowed to
+// the range of this set.
+func (set *TreeSet[T]) view(fromElement T, hasFrom bool, toElement T, hasTo bool) *TreeSet[T] {
+	newSet := new(TreeSet[T])
+	newSet.tree = set.tree.Sub(fromElement, hasFrom, toElement, hasTo)
+
+	return newSet
+}
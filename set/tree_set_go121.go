@@ -0,0 +1,10 @@
+//go:build go1.21
+
+package set
+
+import "cmp"
+
+// NewOrderedTreeSet creates a new TreeSet that sorts the elements in their natural order.
+func NewOrderedTreeSet[T cmp.Ordered]() *TreeSet[T] {
+	return NewTreeSet(cmp.Compare[T])
+}
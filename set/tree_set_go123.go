@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// DescendingIter returns an iterator of all elements in this set in descending order.
+func (set *TreeSet[T]) DescendingIter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := set.tree.Last(); n != nil; {
+			prev := set.tree.Prev(n)
+			if !yield(n.Key) {
+				break
+			}
+			n = prev
+		}
+	}
+}
+
+// Iter returns an iterator of all elements in this set in ascending order.
+func (set *TreeSet[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := set.tree.First(); n != nil; {
+			next := set.tree.Next(n)
+			if !yield(n.Key) {
+				break
+			}
+			n = next
+		}
+	}
+}
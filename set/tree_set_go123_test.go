@@ -0,0 +1,26 @@
+//go:build go1.23
+
+package set
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestTreeSetDescendingIter(t *testing.T) {
+	a := assert.New(t)
+	set := NewOrderedTreeSet[int]()
+	set.AddAll(30, 10, 50, 20, 40)
+
+	res := make([]int, 0, set.Size())
+	for e := range set.DescendingIter() {
+		res = append(res, e)
+	}
+	a.EqualNow([]int{50, 40, 30, 20, 10}, res)
+
+	for range set.DescendingIter() {
+		// yield should returns false
+		break
+	}
+}
@@ -0,0 +1,10 @@
+//go:build !go1.21
+
+package set
+
+import "github.com/ghosind/collection/internal"
+
+// NewOrderedTreeSet creates a new TreeSet that sorts the elements in their natural order.
+func NewOrderedTreeSet[T internal.Ordered]() *TreeSet[T] {
+	return NewTreeSet(internal.Compare[T])
+}
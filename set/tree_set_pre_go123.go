@@ -0,0 +1,33 @@
+//go:build !go1.23
+
+package set
+
+// DescendingIter returns a channel of all elements in this set in descending order.
+func (set *TreeSet[T]) DescendingIter() <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		for n := set.tree.Last(); n != nil; n = set.tree.Prev(n) {
+			ch <- n.Key
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
+
+// Iter returns a channel of all elements in this set in ascending order.
+func (set *TreeSet[T]) Iter() <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		for n := set.tree.First(); n != nil; n = set.tree.Next(n) {
+			ch <- n.Key
+		}
+
+		close(ch)
+	}()
+
+	return ch
+}
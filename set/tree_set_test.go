@@ -0,0 +1,162 @@
+package set
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/go-assert"
+)
+
+var treeSetConstructor = func(initData ...[]int) collection.Set[int] {
+	if len(initData) > 0 && len(initData[0]) > 0 {
+		return NewTreeSetFrom(intComparator, initData[0]...)
+	}
+	return NewTreeSet(intComparator)
+}
+
+func intComparator(a, b int) int {
+	return a - b
+}
+
+func TestTreeSet(t *testing.T) {
+	a := assert.New(t)
+
+	testSet(a, treeSetConstructor)
+}
+
+func BenchmarkTreeSet_Add(b *testing.B) {
+	benchmarkSet_Add(b, treeSetConstructor, false)
+}
+
+func BenchmarkTreeSet_Contains(b *testing.B) {
+	benchmarkSet_Contains(b, treeSetConstructor, false)
+}
+
+var lockTreeSetConstructor = func(initData ...[]int) collection.Set[int] {
+	return NewLockSet(treeSetConstructor(initData...))
+}
+
+func TestLockTreeSet(t *testing.T) {
+	a := assert.New(t)
+
+	testSet(a, lockTreeSetConstructor)
+}
+
+func TestTreeSetOrder(t *testing.T) {
+	a := assert.New(t)
+	set := NewOrderedTreeSet[int]()
+	set.AddAll(testNums1...)
+
+	a.EqualNow([]int{11, 13, 17, 19, 23, 29, 31, 37, 42, 47}, set.ToSlice())
+	a.EqualNow("set[11 13 17 19 23 29 31 37 42 47]", set.String())
+
+	b, err := json.Marshal(set)
+	a.NilNow(err)
+	a.EqualNow("[11,13,17,19,23,29,31,37,42,47]", string(b))
+
+	var zero TreeSet[int]
+	a.EqualNow(collection.ErrNoComparator, zero.UnmarshalJSON(b))
+}
+
+func TestTreeSetNavigation(t *testing.T) {
+	a := assert.New(t)
+	set := NewOrderedTreeSet[int]()
+
+	_, ok := set.First()
+	a.NotTrueNow(ok)
+	_, ok = set.Last()
+	a.NotTrueNow(ok)
+	_, ok = set.PollFirst()
+	a.NotTrueNow(ok)
+	_, ok = set.PollLast()
+	a.NotTrueNow(ok)
+
+	set.AddAll(30, 10, 50, 20, 40)
+
+	testTreeSetElement(a, 10, true)(set.First())
+	testTreeSetElement(a, 50, true)(set.Last())
+	testTreeSetElement(a, 20, true)(set.Floor(25))
+	testTreeSetElement(a, 30, true)(set.Floor(30))
+	testTreeSetElement(a, 0, false)(set.Floor(5))
+	testTreeSetElement(a, 30, true)(set.Ceiling(25))
+	testTreeSetElement(a, 30, true)(set.Ceiling(30))
+	testTreeSetElement(a, 0, false)(set.Ceiling(55))
+	testTreeSetElement(a, 20, true)(set.Lower(30))
+	testTreeSetElement(a, 0, false)(set.Lower(10))
+	testTreeSetElement(a, 40, true)(set.Higher(30))
+	testTreeSetElement(a, 0, false)(set.Higher(50))
+
+	testTreeSetElement(a, 10, true)(set.PollFirst())
+	testTreeSetElement(a, 50, true)(set.PollLast())
+	a.EqualNow([]int{20, 30, 40}, set.ToSlice())
+}
+
+func TestTreeSetRange(t *testing.T) {
+	a := assert.New(t)
+	set := NewOrderedTreeSet[int]()
+	set.AddAll(30, 10, 50, 20, 40)
+
+	a.EqualNow([]int{10, 20}, set.HeadSet(30).ToSlice())
+	a.EqualNow([]int{}, set.HeadSet(10).ToSlice())
+	a.EqualNow([]int{30, 40, 50}, set.TailSet(30).ToSlice())
+	a.EqualNow([]int{30, 40, 50}, set.TailSet(25).ToSlice())
+	a.EqualNow([]int{20, 30}, set.SubSet(20, 40).ToSlice())
+	a.EqualNow([]int{20, 30, 40}, set.SubSet(15, 45).ToSlice())
+
+	sub := set.SubSet(20, 40).(*TreeSet[int])
+	a.TrueNow(sub.Add(25))
+	a.TrueNow(sub.Contains(25))
+	a.TrueNow(set.Contains(25))
+	set.Add(35)
+	a.EqualNow([]int{20, 25, 30, 35}, sub.ToSlice())
+	a.EqualNow(4, sub.Size())
+	set.Remove(30)
+	a.TrueNow(sub.Remove(20))
+	a.EqualNow([]int{10, 25, 35, 40, 50}, set.ToSlice())
+
+	a.NotTrueNow(sub.Contains(10))
+	a.NotTrueNow(sub.Remove(40))
+	a.TrueNow(set.Contains(40))
+	a.PanicOfNow(func() {
+		sub.Add(40)
+	}, collection.ErrKeyOutOfRange)
+	a.PanicOfNow(func() {
+		sub.AddAll(30, 10)
+	}, collection.ErrKeyOutOfRange)
+	a.TrueNow(set.Contains(30))
+	set.Remove(30)
+
+	testTreeSetElement(a, 25, true)(sub.First())
+	testTreeSetElement(a, 35, true)(sub.Last())
+	testTreeSetElement(a, 25, true)(sub.Ceiling(0))
+	testTreeSetElement(a, 35, true)(sub.Floor(100))
+	testTreeSetElement(a, 0, false)(sub.Higher(35))
+	testTreeSetElement(a, 0, false)(sub.Lower(25))
+
+	a.EqualNow([]int{25}, sub.HeadSet(30).ToSlice())
+	a.EqualNow([]int{25, 35}, sub.SubSet(0, 100).ToSlice())
+	a.EqualNow([]int{35}, set.TailSet(30).HeadSet(40).ToSlice())
+	a.TrueNow(sub.Equals(set.SubSet(20, 40)))
+
+	clone := sub.Clone()
+	clone.Add(60)
+	a.EqualNow([]int{25, 35, 60}, clone.ToSlice())
+	a.EqualNow(collection.ErrKeyOutOfRange, json.Unmarshal([]byte("[25,45]"), sub))
+	a.NilNow(json.Unmarshal([]byte("[25,30]"), sub))
+	a.EqualNow([]int{10, 25, 30, 40, 50}, set.ToSlice())
+
+	e, ok := sub.PollLast()
+	a.TrueNow(ok)
+	a.EqualNow(30, e)
+	sub.Clear()
+	a.TrueNow(sub.IsEmpty())
+	a.EqualNow([]int{10, 40, 50}, set.ToSlice())
+}
+
+func testTreeSetElement(a *assert.Assertion, expected int, expectedOk bool) func(int, bool) {
+	return func(e int, ok bool) {
+		a.EqualNow(expectedOk, ok)
+		a.EqualNow(expected, e)
+	}
+}
@@ -0,0 +1,359 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+)
+
+// chunksPerWorker is the number of the index ranges that are assigned to each worker on average,
+// the workers pull the ranges one by one so the faster workers take more ranges.
+const chunksPerWorker = 4
+
+// errStop is returned by the handlers to stop reading the elements without an error.
+var errStop = errors.New("stop")
+
+// ParallelStream is a stream that processes the elements of a SequencedCollection by a bounded pool
+// of goroutines. The collection is split into index ranges and the elements are read by Get, so the
+// source should support the random access efficiently like ArrayList.
+//
+// The stages of the stream are evaluated by the workers concurrently, so the functions of the stages
+// must be safe for concurrent use. The terminal operations stop all workers by cancelling the
+// context once the first error is returned or the first panic is recovered, and return that error.
+// The results are not in the order of the source unless the stream is Ordered.
+type ParallelStream[T any] struct {
+	size    func() int
+	read    func(ctx context.Context, i int, yield func(T) error) error
+	workers int
+	ordered bool
+}
+
+// Parallel creates a new parallel stream of the elements of the collection, the stream uses
+// GOMAXPROCS workers by default.
+func Parallel[T any](c collection.SequencedCollection[T]) *ParallelStream[T] {
+	p := new(ParallelStream[T])
+	p.size = c.Size
+	p.read = func(_ context.Context, i int, yield func(T) error) error {
+		return yield(c.Get(i))
+	}
+	p.workers = runtime.GOMAXPROCS(0)
+
+	return p
+}
+
+// ParallelMap returns a parallel stream that contains the results of applying the mapper function
+// to the elements of the stream, the stream is stopped if the mapper function returns an error.
+func ParallelMap[T, R any](
+	p *ParallelStream[T],
+	mapper func(ctx context.Context, e T) (R, error),
+) *ParallelStream[R] {
+	return newParallelStream(p, func(ctx context.Context, i int, yield func(R) error) error {
+		return p.read(ctx, i, func(e T) error {
+			r, err := mapper(ctx, e)
+			if err != nil {
+				return err
+			}
+			return yield(r)
+		})
+	})
+}
+
+// AnyMatch returns true if any element of the stream satisfies the predicate, all workers are
+// stopped once an element satisfies the predicate.
+func (p *ParallelStream[T]) AnyMatch(ctx context.Context, predicate func(T) bool) (bool, error) {
+	_, found, err := p.Filter(predicate).Unordered().FindFirst(ctx)
+	return found, err
+}
+
+// Count returns the number of the elements in the stream.
+func (p *ParallelStream[T]) Count(ctx context.Context) (int, error) {
+	counts := make([]int, p.workers)
+	err := p.run(ctx, p.size(), nil, func(_ context.Context, worker, _ int, _ T) error {
+		counts[worker]++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, c := range counts {
+		count += c
+	}
+
+	return count, nil
+}
+
+// Filter returns a parallel stream that contains the elements of the stream that satisfy the
+// predicate.
+func (p *ParallelStream[T]) Filter(predicate func(T) bool) *ParallelStream[T] {
+	return newParallelStream(p, func(ctx context.Context, i int, yield func(T) error) error {
+		return p.read(ctx, i, func(e T) error {
+			if !predicate(e) {
+				return nil
+			}
+			return yield(e)
+		})
+	})
+}
+
+// FindFirst returns the first element of the stream in the order of the source if the stream is
+// ordered, or any element of the stream otherwise. It returns false if the stream is empty. The
+// workers are stopped once the result is determined.
+func (p *ParallelStream[T]) FindFirst(ctx context.Context) (T, bool, error) {
+	var mu sync.Mutex
+	var first T
+	var firstChunk atomic.Int64
+	firstChunk.Store(math.MaxInt64)
+
+	stop := func(chunk int) bool {
+		found := firstChunk.Load()
+		if p.ordered {
+			return int64(chunk) > found
+		}
+		return found != math.MaxInt64
+	}
+
+	err := p.run(ctx, p.size(), stop, func(_ context.Context, _, chunk int, e T) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if int64(chunk) < firstChunk.Load() {
+			first = e
+			firstChunk.Store(int64(chunk))
+		}
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, false, err
+	}
+
+	return first, firstChunk.Load() != math.MaxInt64, nil
+}
+
+// ForEach performs the action for each element of the stream by the workers concurrently, the
+// order of the elements is not guaranteed even if the stream is ordered. The stream is stopped if
+// the action returns an error.
+func (p *ParallelStream[T]) ForEach(ctx context.Context, action func(ctx context.Context, e T) error) error {
+	return p.run(ctx, p.size(), nil, func(ctx context.Context, _, _ int, e T) error {
+		return action(ctx, e)
+	})
+}
+
+// Ordered returns a parallel stream that keeps the order of the source in the results of ToSlice,
+// ToList and FindFirst, it takes the extra memory to buffer the results of each index range.
+func (p *ParallelStream[T]) Ordered() *ParallelStream[T] {
+	s := p.clone()
+	s.ordered = true
+	return s
+}
+
+// Sequential returns a sequential stream of the elements of the parallel stream, the elements are
+// read by the stages of the parallel stream on the calling goroutine. The sequential stream stops
+// at the first error, and panics with it.
+func (p *ParallelStream[T]) Sequential() *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		size := p.size()
+		for i := 0; i < size; i++ {
+			err := p.read(context.Background(), i, func(e T) error {
+				if !yield(e) {
+					return errStop
+				}
+				return nil
+			})
+			if err == errStop {
+				return
+			} else if err != nil {
+				panic(err)
+			}
+		}
+	})
+}
+
+// ToList collects the elements of the stream into a new ArrayList.
+func (p *ParallelStream[T]) ToList(ctx context.Context) (*list.ArrayList[T], error) {
+	elements, err := p.ToSlice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return list.NewArrayListFrom(elements...), nil
+}
+
+// ToSlice collects the elements of the stream into a new slice.
+func (p *ParallelStream[T]) ToSlice(ctx context.Context) ([]T, error) {
+	size := p.size()
+	var parts [][]T
+	if p.ordered {
+		_, chunks := p.split(size)
+		parts = make([][]T, chunks)
+	} else {
+		parts = make([][]T, p.workers)
+	}
+
+	err := p.run(ctx, size, nil, func(_ context.Context, worker, chunk int, e T) error {
+		if p.ordered {
+			parts[chunk] = append(parts[chunk], e)
+		} else {
+			parts[worker] = append(parts[worker], e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	elements := make([]T, 0, total)
+	for _, part := range parts {
+		elements = append(elements, part...)
+	}
+
+	return elements, nil
+}
+
+// Unordered returns a parallel stream that does not keep the order of the source in the results.
+func (p *ParallelStream[T]) Unordered() *ParallelStream[T] {
+	s := p.clone()
+	s.ordered = false
+	return s
+}
+
+// Workers returns a parallel stream that runs on the specified number of goroutines, it panics
+// with ErrInvalidSize if the number is not positive.
+func (p *ParallelStream[T]) Workers(n int) *ParallelStream[T] {
+	if n <= 0 {
+		panic(collection.ErrInvalidSize)
+	}
+
+	s := p.clone()
+	s.workers = n
+	return s
+}
+
+// clone returns a shallow copy of the parallel stream.
+func (p *ParallelStream[T]) clone() *ParallelStream[T] {
+	s := new(ParallelStream[T])
+	*s = *p
+	return s
+}
+
+// newParallelStream creates a new parallel stream that has the same source and options of the
+// parent stream, and reads the elements by the specified function.
+func newParallelStream[T, R any](
+	parent *ParallelStream[T],
+	read func(ctx context.Context, i int, yield func(R) error) error,
+) *ParallelStream[R] {
+	p := new(ParallelStream[R])
+	p.size = parent.size
+	p.read = read
+	p.workers = parent.workers
+	p.ordered = parent.ordered
+
+	return p
+}
+
+// run reads the first size elements of the stream by the workers, and performs the handler for
+// each element with the index of the worker and the index of the range of the element. The workers
+// stop pulling the ranges once the stop function returns true for the current range. It returns the
+// first error that is returned by the stages or the handler, or the error of the context if it is
+// done before all elements are read.
+func (p *ParallelStream[T]) run(
+	ctx context.Context,
+	size int,
+	stop func(chunk int) bool,
+	handle func(ctx context.Context, worker, chunk int, e T) error,
+) error {
+	chunkSize, chunks := p.split(size)
+	if chunks == 0 {
+		return ctx.Err()
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := runCtx.Done()
+
+	var firstErr error
+	var once sync.Once
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < p.workers; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					fail(fmt.Errorf("%w: %v", collection.ErrWorkerPanic, r))
+				}
+			}()
+
+			for {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= chunks {
+					return
+				}
+
+				end := (chunk + 1) * chunkSize
+				if end > size {
+					end = size
+				}
+				for i := chunk * chunkSize; i < end; i++ {
+					select {
+					case <-done:
+						return
+					default:
+					}
+					if stop != nil && stop(chunk) {
+						return
+					}
+
+					err := p.read(runCtx, i, func(e T) error {
+						return handle(runCtx, worker, chunk, e)
+					})
+					if err != nil {
+						fail(err)
+						return
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// split returns the size of each index range and the number of the ranges for the source of the
+// specified size.
+func (p *ParallelStream[T]) split(size int) (chunkSize, chunks int) {
+	if size <= 0 {
+		return 0, 0
+	}
+
+	n := p.workers * chunksPerWorker
+	chunkSize = (size + n - 1) / n
+	chunks = (size + chunkSize - 1) / chunkSize
+
+	return chunkSize, chunks
+}
@@ -0,0 +1,196 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/go-assert"
+)
+
+func newRangeList(n int) *list.ArrayList[int] {
+	l := list.NewArrayList[int]()
+	for i := 0; i < n; i++ {
+		l.Add(i)
+	}
+	return l
+}
+
+func double(_ context.Context, e int) (int, error) {
+	return e * 2, nil
+}
+
+func TestParallelStream(t *testing.T) {
+	a := assert.New(t)
+	l := newRangeList(100000)
+	ctx := context.Background()
+
+	expected := Map(From[int](l).Filter(isEven), func(e int) int {
+		return e * 2
+	}).ToSlice()
+
+	p := ParallelMap(Parallel[int](l).Workers(8).Filter(isEven), double)
+
+	elements, err := p.Ordered().ToSlice(ctx)
+	a.NilNow(err)
+	a.EqualNow(expected, elements)
+
+	elements, err = p.ToSlice(ctx)
+	a.NilNow(err)
+	sort.Ints(elements)
+	a.EqualNow(expected, elements)
+
+	ordered, err := p.Ordered().ToList(ctx)
+	a.NilNow(err)
+	a.EqualNow(expected, ordered.ToSlice())
+
+	count, err := p.Count(ctx)
+	a.NilNow(err)
+	a.EqualNow(50000, count)
+
+	a.EqualNow(expected, p.Sequential().ToSlice())
+
+	var sum atomic.Int64
+	err = p.ForEach(ctx, func(_ context.Context, e int) error {
+		sum.Add(int64(e))
+		return nil
+	})
+	a.NilNow(err)
+	a.EqualNow(int64(4999900000), sum.Load())
+}
+
+func TestParallelStreamEmpty(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	p := Parallel[int](list.NewArrayList[int]())
+
+	elements, err := p.Ordered().ToSlice(ctx)
+	a.NilNow(err)
+	a.EqualNow([]int{}, elements)
+
+	count, err := p.Count(ctx)
+	a.NilNow(err)
+	a.EqualNow(0, count)
+
+	_, found, err := p.FindFirst(ctx)
+	a.NilNow(err)
+	a.NotTrueNow(found)
+
+	elements, err = Parallel[int](list.NewArrayListFrom(1, 2, 3)).Workers(8).Ordered().ToSlice(ctx)
+	a.NilNow(err)
+	a.EqualNow([]int{1, 2, 3}, elements)
+
+	a.PanicOfNow(func() {
+		p.Workers(0)
+	}, collection.ErrInvalidSize)
+}
+
+func TestParallelStreamFindFirst(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	l := newRangeList(100000)
+
+	var read atomic.Int64
+	p := ParallelMap(Parallel[int](l).Workers(4), func(_ context.Context, e int) (int, error) {
+		read.Add(1)
+		return e, nil
+	}).Filter(func(e int) bool {
+		return e%1000 == 999
+	})
+
+	v, found, err := p.Ordered().FindFirst(ctx)
+	a.NilNow(err)
+	a.TrueNow(found)
+	a.EqualNow(999, v)
+	a.TrueNow(read.Load() < 100000, "read %d elements", read.Load())
+
+	v, found, err = p.FindFirst(ctx)
+	a.NilNow(err)
+	a.TrueNow(found)
+	a.EqualNow(999, v%1000)
+
+	matched, err := p.AnyMatch(ctx, func(e int) bool {
+		return e > 50000
+	})
+	a.NilNow(err)
+	a.TrueNow(matched)
+
+	matched, err = p.AnyMatch(ctx, func(e int) bool {
+		return e < 0
+	})
+	a.NilNow(err)
+	a.NotTrueNow(matched)
+}
+
+func TestParallelStreamError(t *testing.T) {
+	a := assert.New(t)
+	ctx := context.Background()
+	l := newRangeList(100000)
+	errTest := errors.New("test error")
+
+	var read atomic.Int64
+	p := ParallelMap(Parallel[int](l).Workers(4), func(ctx context.Context, e int) (int, error) {
+		read.Add(1)
+		if e == 10 {
+			return 0, errTest
+		}
+		return e, nil
+	})
+
+	_, err := p.ToSlice(ctx)
+	a.TrueNow(errors.Is(err, errTest))
+	a.TrueNow(read.Load() < 100000, "read %d elements", read.Load())
+
+	var workerCtx atomic.Value
+	err = Parallel[int](l).Workers(4).ForEach(ctx, func(ctx context.Context, e int) error {
+		if e == 10 {
+			workerCtx.Store(ctx)
+			return errTest
+		}
+		return nil
+	})
+	a.TrueNow(errors.Is(err, errTest))
+	a.TrueNow(errors.Is(workerCtx.Load().(context.Context).Err(), context.Canceled))
+
+	_, err = Parallel[int](l).Workers(4).Filter(func(e int) bool {
+		if e == 20000 {
+			panic("test panic")
+		}
+		return true
+	}).Count(ctx)
+	a.TrueNow(errors.Is(err, collection.ErrWorkerPanic))
+	a.EqualNow("worker panicked: test panic", err.Error())
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = Parallel[int](l).Count(cancelledCtx)
+	a.TrueNow(errors.Is(err, context.Canceled))
+
+	a.PanicOfNow(func() {
+		p.Sequential().Count()
+	}, errTest)
+}
+
+func TestParallelStreamWorkers(t *testing.T) {
+	a := assert.New(t)
+	l := newRangeList(10000)
+
+	var running, maxRunning atomic.Int64
+	err := Parallel[int](l).Workers(3).ForEach(context.Background(), func(context.Context, int) error {
+		n := running.Add(1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		running.Add(-1)
+		return nil
+	})
+	a.NilNow(err)
+	a.TrueNow(maxRunning.Load() <= 3, "%d workers are running", maxRunning.Load())
+}
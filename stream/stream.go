@@ -0,0 +1,324 @@
+package stream
+
+import (
+	"sort"
+
+	"github.com/ghosind/collection"
+	"github.com/ghosind/collection/dict"
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+)
+
+// Stream is a lazy sequence of elements that supports the intermediate operations and the
+// terminal operations. The intermediate operations like Filter and Limit return a new stream
+// without reading any element, and the elements are pulled from the source one by one only when a
+// terminal operation like Count or FindFirst is performed. The terminal operations stop reading the
+// source as soon as the result is determined, so the intermediate results are never materialized
+// except for Sorted.
+//
+// A stream can be consumed more than once if its source can be iterated more than once, and each
+// terminal operation reads the source again.
+type Stream[T any] struct {
+	each func(yield func(T) bool)
+}
+
+// Of creates a new stream of the specified elements.
+func Of[T any](elements ...T) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		for _, e := range elements {
+			if !yield(e) {
+				return
+			}
+		}
+	})
+}
+
+// Distinct returns a stream that contains the distinct elements of the stream, the elements are
+// kept in the order of their first occurrences.
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		s.each(func(e T) bool {
+			if _, ok := seen[e]; ok {
+				return true
+			}
+			seen[e] = struct{}{}
+			return yield(e)
+		})
+	})
+}
+
+// Map returns a stream that contains the results of applying the mapper function to the elements
+// of the stream.
+func Map[T, R any](s *Stream[T], mapper func(T) R) *Stream[R] {
+	return newStream(func(yield func(R) bool) {
+		s.each(func(e T) bool {
+			return yield(mapper(e))
+		})
+	})
+}
+
+// ToDict collects the elements of the stream into a new HashDict with the keys and values returned
+// by the key and value functions, the later elements overwrite the earlier ones with the same key.
+func ToDict[T any, K comparable, V any](
+	s *Stream[T],
+	key func(T) K,
+	value func(T) V,
+) *dict.HashDict[K, V] {
+	d := dict.NewHashDict[K, V]()
+	s.each(func(e T) bool {
+		d.Put(key(e), value(e))
+		return true
+	})
+
+	return d
+}
+
+// ToSet collects the elements of the stream into a new HashSet.
+func ToSet[T comparable](s *Stream[T]) *set.HashSet[T] {
+	hs := set.NewHashSet[T]()
+	s.each(func(e T) bool {
+		hs.Add(e)
+		return true
+	})
+
+	return hs
+}
+
+// AllMatch returns true if all the elements of the stream satisfy the predicate, it stops at the
+// first element that does not satisfy the predicate. It returns true if the stream is empty.
+func (s *Stream[T]) AllMatch(predicate func(T) bool) bool {
+	matched := true
+	s.each(func(e T) bool {
+		matched = predicate(e)
+		return matched
+	})
+
+	return matched
+}
+
+// AnyMatch returns true if any element of the stream satisfies the predicate, it stops at the
+// first element that satisfies the predicate.
+func (s *Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	matched := false
+	s.each(func(e T) bool {
+		matched = predicate(e)
+		return !matched
+	})
+
+	return matched
+}
+
+// Collect adds all the elements of the stream into the specified collection, and returns the
+// collection.
+func (s *Stream[T]) Collect(c collection.Collection[T]) collection.Collection[T] {
+	s.each(func(e T) bool {
+		c.Add(e)
+		return true
+	})
+
+	return c
+}
+
+// Count returns the number of the elements in the stream.
+func (s *Stream[T]) Count() int {
+	count := 0
+	s.each(func(T) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+// DropWhile returns a stream that skips the leading elements of the stream that satisfy the
+// predicate, and contains the remaining elements.
+func (s *Stream[T]) DropWhile(predicate func(T) bool) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		dropping := true
+		s.each(func(e T) bool {
+			if dropping && predicate(e) {
+				return true
+			}
+			dropping = false
+			return yield(e)
+		})
+	})
+}
+
+// Filter returns a stream that contains the elements of the stream that satisfy the predicate.
+func (s *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		s.each(func(e T) bool {
+			if !predicate(e) {
+				return true
+			}
+			return yield(e)
+		})
+	})
+}
+
+// FindFirst returns the first element of the stream, it returns false if the stream is empty.
+func (s *Stream[T]) FindFirst() (T, bool) {
+	var first T
+	found := false
+	s.each(func(e T) bool {
+		first = e
+		found = true
+		return false
+	})
+
+	return first, found
+}
+
+// ForEach performs the action for each element of the stream.
+func (s *Stream[T]) ForEach(action func(T)) {
+	s.each(func(e T) bool {
+		action(e)
+		return true
+	})
+}
+
+// Limit returns a stream that contains at most the first n elements of the stream, it stops
+// reading the source after the n-th element.
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		count := 0
+		s.each(func(e T) bool {
+			if !yield(e) {
+				return false
+			}
+			count++
+			return count < n
+		})
+	})
+}
+
+// Max returns the maximum element of the stream by the comparison function, the first one is
+// returned if there are several maximum elements. It returns false if the stream is empty.
+func (s *Stream[T]) Max(cmp func(a, b T) int) (T, bool) {
+	return s.reduce(func(acc, e T) bool {
+		return cmp(e, acc) > 0
+	})
+}
+
+// Min returns the minimum element of the stream by the comparison function, the first one is
+// returned if there are several minimum elements. It returns false if the stream is empty.
+func (s *Stream[T]) Min(cmp func(a, b T) int) (T, bool) {
+	return s.reduce(func(acc, e T) bool {
+		return cmp(e, acc) < 0
+	})
+}
+
+// NoneMatch returns true if no element of the stream satisfies the predicate, it stops at the first
+// element that satisfies the predicate.
+func (s *Stream[T]) NoneMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+// Peek returns a stream that performs the action for each element when the element is read from
+// the stream, it is useful for debugging.
+func (s *Stream[T]) Peek(action func(T)) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		s.each(func(e T) bool {
+			action(e)
+			return yield(e)
+		})
+	})
+}
+
+// Skip returns a stream that discards the first n elements of the stream.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		skipped := 0
+		s.each(func(e T) bool {
+			if skipped < n {
+				skipped++
+				return true
+			}
+			return yield(e)
+		})
+	})
+}
+
+// Sorted returns a stream that contains the elements of the stream sorted by the comparison
+// function, the order of the equal elements is kept. It is a stateful operation that reads all
+// elements of the stream before producing the first element.
+func (s *Stream[T]) Sorted(cmp func(a, b T) int) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		elements := s.ToSlice()
+		sort.SliceStable(elements, func(i, j int) bool {
+			return cmp(elements[i], elements[j]) < 0
+		})
+
+		for _, e := range elements {
+			if !yield(e) {
+				return
+			}
+		}
+	})
+}
+
+// TakeWhile returns a stream that contains the leading elements of the stream that satisfy the
+// predicate, it stops reading the source at the first element that does not satisfy the predicate.
+func (s *Stream[T]) TakeWhile(predicate func(T) bool) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		s.each(func(e T) bool {
+			if !predicate(e) {
+				return false
+			}
+			return yield(e)
+		})
+	})
+}
+
+// ToList collects the elements of the stream into a new ArrayList.
+func (s *Stream[T]) ToList() *list.ArrayList[T] {
+	l := list.NewArrayList[T]()
+	s.each(func(e T) bool {
+		l.Add(e)
+		return true
+	})
+
+	return l
+}
+
+// ToSlice collects the elements of the stream into a new slice.
+func (s *Stream[T]) ToSlice() []T {
+	elements := make([]T, 0)
+	s.each(func(e T) bool {
+		elements = append(elements, e)
+		return true
+	})
+
+	return elements
+}
+
+// newStream creates a new stream with the function that pushes the elements to the yield function
+// until it returns false.
+func newStream[T any](each func(yield func(T) bool)) *Stream[T] {
+	s := new(Stream[T])
+	s.each = each
+
+	return s
+}
+
+// reduce returns the element that is selected by the replace function, the replace function
+// returns true if the element should replace the current accumulated one.
+func (s *Stream[T]) reduce(replace func(acc, e T) bool) (T, bool) {
+	var acc T
+	found := false
+	s.each(func(e T) bool {
+		if !found || replace(acc, e) {
+			acc = e
+			found = true
+		}
+		return true
+	})
+
+	return acc, found
+}
@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"iter"
+
+	"github.com/ghosind/collection"
+)
+
+// From creates a new stream of the elements of the iterable, the elements are read from the
+// iterator of the iterable when a terminal operation is performed.
+func From[T any](it collection.Iterable[T]) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		it.Iter()(yield)
+	})
+}
+
+// FromSeq creates a new stream of the elements of the iterator.
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return newStream(func(yield func(T) bool) {
+		seq(yield)
+	})
+}
+
+// Iter returns an iterator of the elements of the stream, so the stream is also an Iterable.
+func (s *Stream[T]) Iter() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.each(yield)
+	}
+}
@@ -0,0 +1,45 @@
+//go:build !go1.23
+
+package stream
+
+import "github.com/ghosind/collection"
+
+// From creates a new stream of the elements of the iterable, the elements are read when a terminal
+// operation is performed. The collections are read by ForEach so the reading can be stopped early,
+// and the other iterables are read from their channels that are always drained.
+func From[T any](it collection.Iterable[T]) *Stream[T] {
+	if c, ok := it.(interface{ ForEach(func(T) error) error }); ok {
+		return newStream(func(yield func(T) bool) {
+			c.ForEach(func(e T) error {
+				if !yield(e) {
+					return errStop
+				}
+				return nil
+			})
+		})
+	}
+
+	return newStream(func(yield func(T) bool) {
+		ok := true
+		for e := range it.Iter() {
+			if ok {
+				ok = yield(e)
+			}
+		}
+	})
+}
+
+// Iter returns a channel of the elements of the stream, so the stream is also an Iterable.
+func (s *Stream[T]) Iter() <-chan T {
+	ch := make(chan T)
+
+	go func() {
+		s.each(func(e T) bool {
+			ch <- e
+			return true
+		})
+		close(ch)
+	}()
+
+	return ch
+}
@@ -0,0 +1,146 @@
+package stream
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/ghosind/collection/list"
+	"github.com/ghosind/collection/set"
+	"github.com/ghosind/go-assert"
+)
+
+func compareInt(a, b int) int {
+	return a - b
+}
+
+func isEven(e int) bool {
+	return e%2 == 0
+}
+
+func TestStreamIntermediate(t *testing.T) {
+	a := assert.New(t)
+	s := From[int](list.NewArrayListFrom(5, 1, 4, 2, 3, 2, 6))
+
+	a.EqualNow([]int{4, 2, 2, 6}, s.Filter(isEven).ToSlice())
+	a.EqualNow([]int{1, 4, 2}, s.Skip(1).Limit(3).ToSlice())
+	a.EqualNow([]int{5, 1, 4, 2, 3, 2, 6}, s.Skip(0).Limit(100).ToSlice())
+	a.EqualNow(0, s.Limit(0).Count())
+	a.EqualNow(0, s.Skip(100).Count())
+	a.EqualNow([]int{5, 1}, s.TakeWhile(func(e int) bool { return e != 4 }).ToSlice())
+	a.EqualNow([]int{4, 2, 3, 2, 6}, s.DropWhile(func(e int) bool { return e != 4 }).ToSlice())
+	a.EqualNow([]int{1, 2, 2, 3, 4, 5, 6}, s.Sorted(compareInt).ToSlice())
+	a.EqualNow([]int{5, 1, 4, 2, 3, 6}, Distinct(s).ToSlice())
+	a.EqualNow([]string{"4", "4", "12"}, Map(s.Filter(isEven).Skip(1).Limit(3), func(e int) string {
+		return strconv.Itoa(e * 2)
+	}).ToSlice())
+
+	peeked := make([]int, 0)
+	a.EqualNow(3, s.Peek(func(e int) {
+		peeked = append(peeked, e)
+	}).Limit(3).Count())
+	a.EqualNow([]int{5, 1, 4}, peeked)
+}
+
+func TestStreamTerminal(t *testing.T) {
+	a := assert.New(t)
+	s := Of(3, 1, 4, 1, 5, 9, 2, 6)
+	empty := Of[int]()
+
+	a.EqualNow(8, s.Count())
+	a.EqualNow(0, empty.Count())
+
+	a.TrueNow(s.AnyMatch(isEven))
+	a.NotTrueNow(s.AnyMatch(func(e int) bool { return e > 9 }))
+	a.NotTrueNow(empty.AnyMatch(isEven))
+	a.TrueNow(s.AllMatch(func(e int) bool { return e > 0 }))
+	a.NotTrueNow(s.AllMatch(isEven))
+	a.TrueNow(empty.AllMatch(isEven))
+	a.TrueNow(s.NoneMatch(func(e int) bool { return e > 9 }))
+	a.NotTrueNow(s.NoneMatch(isEven))
+
+	v, ok := s.FindFirst()
+	a.TrueNow(ok)
+	a.EqualNow(3, v)
+	v, ok = s.Filter(isEven).FindFirst()
+	a.TrueNow(ok)
+	a.EqualNow(4, v)
+	_, ok = empty.FindFirst()
+	a.NotTrueNow(ok)
+
+	v, ok = s.Min(compareInt)
+	a.TrueNow(ok)
+	a.EqualNow(1, v)
+	v, ok = s.Max(compareInt)
+	a.TrueNow(ok)
+	a.EqualNow(9, v)
+	_, ok = empty.Max(compareInt)
+	a.NotTrueNow(ok)
+
+	sum := 0
+	s.ForEach(func(e int) {
+		sum += e
+	})
+	a.EqualNow(31, sum)
+}
+
+func TestStreamCollect(t *testing.T) {
+	a := assert.New(t)
+	s := Of("apple", "banana", "cherry", "apple")
+
+	a.EqualNow([]string{"apple", "banana", "cherry", "apple"}, s.ToList().ToSlice())
+
+	hs := ToSet(s)
+	a.EqualNow(3, hs.Size())
+	a.TrueNow(hs.ContainsAll("apple", "banana", "cherry"))
+
+	ts := set.NewOrderedTreeSet[string]()
+	s.Collect(ts)
+	a.EqualNow([]string{"apple", "banana", "cherry"}, ts.ToSlice())
+
+	d := ToDict(s, func(e string) byte {
+		return e[0]
+	}, func(e string) int {
+		return len(e)
+	})
+	a.EqualNow(3, d.Size())
+	a.EqualNow(5, d.GetDefault('a', 0))
+	a.EqualNow(6, d.GetDefault('b', 0))
+
+	values := From[int](set.NewHashSetFrom(1, 2, 3)).ToSlice()
+	sort.Ints(values)
+	a.EqualNow([]int{1, 2, 3}, values)
+}
+
+func TestStreamLazy(t *testing.T) {
+	a := assert.New(t)
+	l := list.NewLinkedList[int]()
+	for i := 0; i < 1000000; i++ {
+		l.Add(i)
+	}
+
+	read := 0
+	s := Map(From[int](l).Peek(func(int) {
+		read++
+	}).Filter(isEven), func(e int) int {
+		return e * 10
+	})
+	a.EqualNow(0, read)
+
+	a.EqualNow([]int{0, 20, 40}, s.Limit(3).ToSlice())
+	a.EqualNow(5, read)
+
+	read = 0
+	a.TrueNow(s.AnyMatch(func(e int) bool { return e >= 100 }))
+	a.EqualNow(11, read)
+
+	read = 0
+	a.EqualNow([]int{0, 20}, s.TakeWhile(func(e int) bool { return e < 30 }).ToSlice())
+	a.EqualNow(5, read)
+
+	read = 0
+	v, ok := s.Skip(10).FindFirst()
+	a.TrueNow(ok)
+	a.EqualNow(200, v)
+	a.EqualNow(21, read)
+}